@@ -27,6 +27,28 @@ const (
 	AlertKind string = "Alert"
 )
 
+const (
+	// ScheduleSuppress discards the events received outside the
+	// Alert schedule windows.
+	ScheduleSuppress string = "Suppress"
+
+	// ScheduleDigest defers the events received outside the Alert schedule
+	// windows to a digest notification sent at the start of the next window.
+	ScheduleDigest string = "Digest"
+
+	// ScheduleReroute dispatches the events received outside the Alert
+	// schedule windows to an alternate Provider.
+	ScheduleReroute string = "Reroute"
+)
+
+const (
+	// MentionUser is the type of the mentions of users.
+	MentionUser string = "User"
+
+	// MentionTag is the type of the mentions of tags.
+	MentionTag string = "Tag"
+)
+
 // AlertSpec defines an alerting rule for events involving a list of objects.
 type AlertSpec struct {
 	// ProviderRef specifies which Provider this Alert should use.
@@ -58,6 +80,22 @@ type AlertSpec struct {
 	// +optional
 	EventMetadata map[string]string `json:"eventMetadata,omitempty"`
 
+	// InvolvedObjectMetadata specifies the labels and annotations of the
+	// involved object to be added to the metadata of the events dispatched
+	// by the controller, e.g. for identifying the owner of the object.
+	// +optional
+	InvolvedObjectMetadata *InvolvedObjectMetadata `json:"involvedObjectMetadata,omitempty"`
+
+	// Links is a list of link-back URL templates rendered for each event,
+	// appended to the links of the Provider.
+	// +optional
+	Links []Link `json:"links,omitempty"`
+
+	// Mentions is a list of users or tags mentioned in the notifications,
+	// for the Provider types supporting mentions.
+	// +optional
+	Mentions []Mention `json:"mentions,omitempty"`
+
 	// ExclusionList specifies a list of Golang regular expressions
 	// to be used for excluding messages.
 	// +optional
@@ -71,12 +109,160 @@ type AlertSpec struct {
 	// +deprecated
 	Summary string `json:"summary,omitempty"`
 
+	// Escalations specifies a list of steps for escalating the failures of
+	// the involved objects to other Providers. An escalation step is
+	// triggered when an involved object has not recovered from an error
+	// event within the step delay. Escalations are cancelled by a
+	// subsequent recovery event for the same involved object, i.e. an
+	// info event with a reason like ReconciliationSucceeded. Other info
+	// events, e.g. DependencyNotReady, don't cancel the escalations.
+	// +optional
+	Escalations []AlertEscalation `json:"escalations,omitempty"`
+
+	// InhibitionRules specifies rules for suppressing the events of
+	// dependent objects while a source object is failing.
+	// +optional
+	InhibitionRules []InhibitionRule `json:"inhibitionRules,omitempty"`
+
+	// Schedule specifies the time windows during which events are
+	// dispatched to the Provider, and how the events received
+	// outside those windows are handled.
+	// +optional
+	Schedule *AlertSchedule `json:"schedule,omitempty"`
+
 	// Suspend tells the controller to suspend subsequent
 	// events handling for this Alert.
 	// +optional
 	Suspend bool `json:"suspend,omitempty"`
 }
 
+// InhibitionRule defines a rule for suppressing events while a source object
+// is in a failing state. An object is considered failing from an error event
+// until a subsequent recovery event, i.e. an info event with a reason like
+// ReconciliationSucceeded. Other info events, e.g. DependencyNotReady, don't
+// end the failing state.
+type InhibitionRule struct {
+	// Source selects the objects whose failures inhibit events, by kind,
+	// name and namespace, and optionally by labels. When the namespace is
+	// not specified, the Alert namespace is used.
+	// +required
+	Source v1.CrossNamespaceObjectReference `json:"source"`
+
+	// TargetKinds restricts the inhibited events to the ones involving
+	// objects of the given kinds. Defaults to all kinds.
+	// +optional
+	TargetKinds []string `json:"targetKinds,omitempty"`
+
+	// TargetReasons restricts the inhibited events to the ones with
+	// the given reasons, e.g. 'DependencyNotReady'. Defaults to all reasons.
+	// +optional
+	TargetReasons []string `json:"targetReasons,omitempty"`
+
+	// MatchSourceRef restricts the inhibited events to the ones involving
+	// objects which reference the failing source object in '.spec.sourceRef',
+	// '.spec.chartRef' or '.spec.chart.spec.sourceRef'.
+	// +optional
+	MatchSourceRef bool `json:"matchSourceRef,omitempty"`
+}
+
+// AlertSchedule defines the active time windows of an Alert.
+// +kubebuilder:validation:XValidation:rule="self.outsideWindows != 'Reroute' || has(self.rerouteProviderRef)", message="spec.schedule.rerouteProviderRef is required when spec.schedule.outsideWindows is 'Reroute'"
+type AlertSchedule struct {
+	// TimeZone is the IANA time zone name in which the windows are
+	// evaluated, e.g. 'Europe/Berlin'. Defaults to UTC.
+	// +optional
+	TimeZone string `json:"timeZone,omitempty"`
+
+	// Windows specifies the time windows during which the Alert is active.
+	// +kubebuilder:validation:MinItems=1
+	// +required
+	Windows []AlertScheduleWindow `json:"windows"`
+
+	// OutsideWindows specifies how events received outside the windows are
+	// handled. 'Suppress' discards the events, 'Digest' sends a summary of
+	// the events at the start of the next window, and 'Reroute' dispatches
+	// the events to the Provider specified in RerouteProviderRef.
+	// +kubebuilder:validation:Enum=Suppress;Digest;Reroute
+	// +kubebuilder:default:=Suppress
+	// +optional
+	OutsideWindows string `json:"outsideWindows,omitempty"`
+
+	// RerouteProviderRef specifies which Provider the events received outside
+	// the windows are dispatched to when OutsideWindows is set to 'Reroute'.
+	// +optional
+	RerouteProviderRef *meta.LocalObjectReference `json:"rerouteProviderRef,omitempty"`
+}
+
+// AlertScheduleWindow defines a recurring time window.
+type AlertScheduleWindow struct {
+	// Days specifies the weekdays on which the window starts.
+	// Defaults to every day of the week.
+	// +kubebuilder:validation:items:Enum=Monday;Tuesday;Wednesday;Thursday;Friday;Saturday;Sunday
+	// +optional
+	Days []string `json:"days,omitempty"`
+
+	// Start is the time of day at which the window starts, in the HH:MM format.
+	// +kubebuilder:validation:Pattern="^([01][0-9]|2[0-3]):[0-5][0-9]$"
+	// +required
+	Start string `json:"start"`
+
+	// End is the time of day at which the window ends, in the HH:MM format.
+	// When End is not after Start, the window ends on the next day.
+	// +kubebuilder:validation:Pattern="^(([01][0-9]|2[0-3]):[0-5][0-9]|24:00)$"
+	// +required
+	End string `json:"end"`
+}
+
+// InvolvedObjectMetadata defines an allowlist of involved object
+// labels and annotations.
+type InvolvedObjectMetadata struct {
+	// Labels is the list of label keys whose values are
+	// added to the event metadata under the same keys.
+	// +optional
+	Labels []string `json:"labels,omitempty"`
+
+	// Annotations is the list of annotation keys whose values
+	// are added to the event metadata under the same keys.
+	// +optional
+	Annotations []string `json:"annotations,omitempty"`
+}
+
+// Mention defines a user or a tag mentioned in the notifications.
+type Mention struct {
+	// ID of the mentioned user or tag, e.g. the Microsoft Entra object ID
+	// or the user principal name of a Microsoft Teams user, or the ID of a
+	// Microsoft Teams tag. For DingTalk, the mobile number of the user, and
+	// for WeCom, the user ID.
+	// +required
+	ID string `json:"id"`
+
+	// Name displayed for the mention.
+	// +required
+	Name string `json:"name"`
+
+	// Type of the mentioned entity, User or Tag.
+	// +kubebuilder:validation:Enum=User;Tag
+	// +kubebuilder:default:=User
+	// +optional
+	Type string `json:"type,omitempty"`
+}
+
+// AlertEscalation defines a step for escalating the failure of an involved
+// object to a Provider.
+type AlertEscalation struct {
+	// After is the duration for which an involved object must remain in a
+	// failed state before the escalation notification is dispatched.
+	// +kubebuilder:validation:Type=string
+	// +kubebuilder:validation:Pattern="^([0-9]+(\\.[0-9]+)?(ms|s|m|h))+$"
+	// +required
+	After metav1.Duration `json:"after"`
+
+	// ProviderRef specifies which Provider the escalation
+	// notification should be dispatched to.
+	// +required
+	ProviderRef meta.LocalObjectReference `json:"providerRef"`
+}
+
 // +genclient
 // +kubebuilder:storageversion
 // +kubebuilder:object:root=true
@@ -35,10 +35,13 @@ const (
 	GitHubDispatchProvider            string = "githubdispatch"
 	GitHubProvider                    string = "github"
 	GitHubPullRequestCommentProvider  string = "githubpullrequestcomment"
+	GitHubIssueProvider               string = "githubissue"
 	GitLabProvider                    string = "gitlab"
 	GitLabMergeRequestCommentProvider string = "gitlabmergerequestcomment"
+	GitLabIssueProvider               string = "gitlabissue"
 	GiteaProvider                     string = "gitea"
 	GiteaPullRequestCommentProvider   string = "giteapullrequestcomment"
+	GiteaIssueProvider                string = "giteaissue"
 	BitbucketServerProvider           string = "bitbucketserver"
 	BitbucketProvider                 string = "bitbucket"
 	AzureDevOpsProvider               string = "azuredevops"
@@ -58,13 +61,52 @@ const (
 	ZulipProvider                     string = "zulip"
 	OTELProvider                      string = "otel"
 	ZoomProvider                      string = "zoom"
+	EmailProvider                     string = "email"
+	KafkaProvider                     string = "kafka"
+	MQTTProvider                      string = "mqtt"
+	AMQPProvider                      string = "amqp"
+	AWSSNSProvider                    string = "awssns"
+	AWSSQSProvider                    string = "awssqs"
+	JiraProvider                      string = "jira"
+	ServiceNowProvider                string = "servicenow"
+	SplunkHECProvider                 string = "splunkhec"
+	ElasticsearchProvider             string = "elasticsearch"
+	LokiProvider                      string = "loki"
+	CloudEventsProvider               string = "cloudevents"
+	MattermostProvider                string = "mattermost"
+	NtfyProvider                      string = "ntfy"
+	GotifyProvider                    string = "gotify"
+	PushoverProvider                  string = "pushover"
+	SplunkOnCallProvider              string = "splunkoncall"
+	IncidentIOProvider                string = "incidentio"
+	GrafanaOnCallProvider             string = "grafanaoncall"
+	DingTalkProvider                  string = "dingtalk"
+	WeComProvider                     string = "wecom"
+	SyslogProvider                    string = "syslog"
+	RedisProvider                     string = "redis"
 )
 
 // ProviderSpec defines the desired state of the Provider.
 // +kubebuilder:validation:XValidation:rule="self.type == 'github' || self.type == 'gitlab' || self.type == 'gitea' || self.type == 'bitbucketserver' || self.type == 'bitbucket' || self.type == 'azuredevops' || !has(self.commitStatusExpr)", message="spec.commitStatusExpr is only supported for the 'github', 'gitlab', 'gitea', 'bitbucketserver', 'bitbucket', 'azuredevops' provider types"
+// +kubebuilder:validation:XValidation:rule="!has(self.email) || self.type == 'email'", message="spec.email is only supported for the 'email' provider type"
+// +kubebuilder:validation:XValidation:rule="!has(self.kafka) || self.type == 'kafka' || self.type == 'cloudevents'", message="spec.kafka is only supported for the 'kafka' and 'cloudevents' provider types"
+// +kubebuilder:validation:XValidation:rule="!has(self.mqtt) || self.type == 'mqtt'", message="spec.mqtt is only supported for the 'mqtt' provider type"
+// +kubebuilder:validation:XValidation:rule="!has(self.amqp) || self.type == 'amqp'", message="spec.amqp is only supported for the 'amqp' provider type"
+// +kubebuilder:validation:XValidation:rule="!has(self.aws) || self.type == 'awssns' || self.type == 'awssqs'", message="spec.aws is only supported for the 'awssns' and 'awssqs' provider types"
+// +kubebuilder:validation:XValidation:rule="!has(self.jira) || self.type == 'jira'", message="spec.jira is only supported for the 'jira' provider type"
+// +kubebuilder:validation:XValidation:rule="!has(self.servicenow) || self.type == 'servicenow'", message="spec.servicenow is only supported for the 'servicenow' provider type"
+// +kubebuilder:validation:XValidation:rule="!has(self.batch) || self.type == 'splunkhec' || self.type == 'elasticsearch' || self.type == 'loki'", message="spec.batch is only supported for the 'splunkhec', 'elasticsearch' and 'loki' provider types"
+// +kubebuilder:validation:XValidation:rule="!has(self.splunkhec) || self.type == 'splunkhec'", message="spec.splunkhec is only supported for the 'splunkhec' provider type"
+// +kubebuilder:validation:XValidation:rule="!has(self.loki) || self.type == 'loki'", message="spec.loki is only supported for the 'loki' provider type"
+// +kubebuilder:validation:XValidation:rule="!has(self.cloudevents) || self.type == 'cloudevents'", message="spec.cloudevents is only supported for the 'cloudevents' provider type"
+// +kubebuilder:validation:XValidation:rule="!has(self.alertmanager) || self.type == 'alertmanager'", message="spec.alertmanager is only supported for the 'alertmanager' provider type"
+// +kubebuilder:validation:XValidation:rule="!has(self.slack) || self.type == 'slack'", message="spec.slack is only supported for the 'slack' provider type"
+// +kubebuilder:validation:XValidation:rule="!has(self.msteams) || self.type == 'msteams'", message="spec.msteams is only supported for the 'msteams' provider type"
+// +kubebuilder:validation:XValidation:rule="!has(self.syslog) || self.type == 'syslog'", message="spec.syslog is only supported for the 'syslog' provider type"
+// +kubebuilder:validation:XValidation:rule="!has(self.redis) || self.type == 'redis'", message="spec.redis is only supported for the 'redis' provider type"
 type ProviderSpec struct {
 	// Type specifies which Provider implementation to use.
-	// +kubebuilder:validation:Enum=slack;discord;msteams;rocket;generic;generic-hmac;github;gitlab;gitea;giteapullrequestcomment;bitbucketserver;bitbucket;azuredevops;googlechat;googlepubsub;webex;sentry;azureeventhub;telegram;lark;matrix;opsgenie;alertmanager;grafana;githubdispatch;githubpullrequestcomment;gitlabmergerequestcomment;pagerduty;datadog;nats;zulip;otel;zoom
+	// +kubebuilder:validation:Enum=slack;discord;msteams;rocket;generic;generic-hmac;github;gitlab;gitea;giteapullrequestcomment;bitbucketserver;bitbucket;azuredevops;googlechat;googlepubsub;webex;sentry;azureeventhub;telegram;lark;matrix;opsgenie;alertmanager;grafana;githubdispatch;githubpullrequestcomment;gitlabmergerequestcomment;pagerduty;datadog;nats;zulip;otel;zoom;email;kafka;mqtt;amqp;awssns;awssqs;jira;githubissue;gitlabissue;giteaissue;servicenow;splunkhec;elasticsearch;loki;cloudevents;mattermost;ntfy;gotify;pushover;splunkoncall;incidentio;grafanaoncall;dingtalk;wecom;syslog;redis
 	// +required
 	Type string `json:"type"`
 
@@ -166,6 +208,329 @@ type ProviderSpec struct {
 	// and alert.
 	// +optional
 	CommitStatusExpr string `json:"commitStatusExpr,omitempty"`
+
+	// Links is a list of link-back URL templates rendered for each event,
+	// e.g. for linking to a dashboard or to the Git commit of the revision.
+	// The links are displayed as buttons or links by the chat providers.
+	// +optional
+	Links []Link `json:"links,omitempty"`
+
+	// Email holds the settings of the email Provider type.
+	// +optional
+	Email *EmailOptions `json:"email,omitempty"`
+
+	// Kafka holds the settings of the kafka Provider type, and of the
+	// Kafka protocol binding of the cloudevents Provider type.
+	// +optional
+	Kafka *KafkaOptions `json:"kafka,omitempty"`
+
+	// MQTT holds the settings of the mqtt Provider type.
+	// +optional
+	MQTT *MQTTOptions `json:"mqtt,omitempty"`
+
+	// AMQP holds the settings of the amqp Provider type.
+	// +optional
+	AMQP *AMQPOptions `json:"amqp,omitempty"`
+
+	// AWS holds the settings of the awssns and awssqs Provider types.
+	// +optional
+	AWS *AWSOptions `json:"aws,omitempty"`
+
+	// Jira holds the settings of the jira Provider type.
+	// +optional
+	Jira *JiraOptions `json:"jira,omitempty"`
+
+	// ServiceNow holds the settings of the servicenow Provider type.
+	// +optional
+	ServiceNow *ServiceNowOptions `json:"servicenow,omitempty"`
+
+	// Batch configures when the events are sent by the splunkhec,
+	// elasticsearch and loki Provider types, which send the events
+	// of a Provider in batches.
+	// +optional
+	Batch *BatchOptions `json:"batch,omitempty"`
+
+	// SplunkHEC holds the settings of the splunkhec Provider type.
+	// +optional
+	SplunkHEC *SplunkHECOptions `json:"splunkhec,omitempty"`
+
+	// Loki holds the settings of the loki Provider type.
+	// +optional
+	Loki *LokiOptions `json:"loki,omitempty"`
+
+	// CloudEvents holds the settings of the cloudevents Provider type.
+	// +optional
+	CloudEvents *CloudEventsOptions `json:"cloudevents,omitempty"`
+
+	// Alertmanager holds the settings of the alertmanager Provider type.
+	// +optional
+	Alertmanager *AlertmanagerOptions `json:"alertmanager,omitempty"`
+
+	// Slack holds the settings of the slack Provider type.
+	// +optional
+	Slack *SlackOptions `json:"slack,omitempty"`
+
+	// MSTeams holds the settings of the msteams Provider type.
+	// +optional
+	MSTeams *MSTeamsOptions `json:"msteams,omitempty"`
+
+	// Syslog holds the settings of the syslog Provider type.
+	// +optional
+	Syslog *SyslogOptions `json:"syslog,omitempty"`
+
+	// Redis holds the settings of the redis Provider type.
+	// +optional
+	Redis *RedisOptions `json:"redis,omitempty"`
+}
+
+// Link defines a URL template rendered for the events.
+type Link struct {
+	// Name is the text displayed for the link.
+	// +kubebuilder:validation:MinLength=1
+	// +required
+	Name string `json:"name"`
+
+	// URL is a Go template rendered with the event, e.g.
+	// 'https://dashboard.example.com/{{ .InvolvedObject.Namespace }}/{{ .InvolvedObject.Name }}'.
+	// The commitURL function returns the URL of the commit of a Git
+	// repository address and a revision, e.g.
+	// '{{ commitURL "https://github.com/org/repo" .Metadata.revision }}'.
+	// Links rendering to an empty string are omitted.
+	// +kubebuilder:validation:MinLength=1
+	// +required
+	URL string `json:"url"`
+}
+
+// EmailOptions defines the settings of the email Provider type.
+type EmailOptions struct {
+	// From is the sender address of the emails.
+	// Defaults to the username of the Provider Secret.
+	// +optional
+	From string `json:"from,omitempty"`
+
+	// AuthMethod is the SMTP authentication mechanism, PLAIN by default.
+	// +kubebuilder:validation:Enum=PLAIN;LOGIN
+	// +optional
+	AuthMethod string `json:"authMethod,omitempty"`
+
+	// Insecure allows sending the emails in plaintext when an 'smtp://'
+	// server doesn't offer STARTTLS. By default, the emails are only sent
+	// over TLS.
+	// +optional
+	Insecure bool `json:"insecure,omitempty"`
+}
+
+// KafkaOptions defines the settings of the Kafka producer.
+type KafkaOptions struct {
+	// SASLMechanism is the SASL mechanism used with the username and
+	// password of the Provider Secret, PLAIN by default.
+	// +kubebuilder:validation:Enum=PLAIN;SCRAM-SHA-256;SCRAM-SHA-512
+	// +optional
+	SASLMechanism string `json:"saslMechanism,omitempty"`
+
+	// TLS enables connecting to the brokers over TLS with the system CA
+	// certificates. TLS is always enabled when CertSecretRef is set.
+	// +optional
+	TLS bool `json:"tls,omitempty"`
+}
+
+// MQTTOptions defines the settings of the mqtt Provider type.
+type MQTTOptions struct {
+	// QoS is the quality of service level of the published messages, 1 by default.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=2
+	// +optional
+	QoS *int32 `json:"qos,omitempty"`
+
+	// Retain publishes the messages with the retained flag, so that the
+	// broker delivers the last event of a topic to new subscribers.
+	// +optional
+	Retain bool `json:"retain,omitempty"`
+}
+
+// AMQPOptions defines the settings of the amqp Provider type.
+type AMQPOptions struct {
+	// RoutingKey is a Go template of the routing key rendered with the event,
+	// 'flux.{{ .Severity }}.{{ .InvolvedObject.Kind }}.{{ .InvolvedObject.Namespace }}.{{ .InvolvedObject.Name }}'
+	// by default.
+	// +kubebuilder:validation:MaxLength:=2048
+	// +optional
+	RoutingKey string `json:"routingKey,omitempty"`
+}
+
+// AWSOptions defines the settings of the AWS service clients.
+type AWSOptions struct {
+	// Region overrides the region taken from the Address, and is
+	// required for addresses that don't contain the region.
+	// +optional
+	Region string `json:"region,omitempty"`
+
+	// Endpoint overrides the service endpoint, e.g. for testing
+	// against a local stand-in.
+	// +kubebuilder:validation:Pattern="^(http|https)://.*$"
+	// +optional
+	Endpoint string `json:"endpoint,omitempty"`
+}
+
+// JiraOptions defines the settings of the jira Provider type.
+type JiraOptions struct {
+	// IssueType is the name or ID of the type of the created issues, 'Bug' by default.
+	// +optional
+	IssueType string `json:"issueType,omitempty"`
+
+	// TransitionID is the ID of the workflow transition performed on
+	// the open issue when the involved object recovers. When not set,
+	// the recovery is added as a comment and the issue is left open.
+	// +optional
+	TransitionID string `json:"transitionID,omitempty"`
+}
+
+// ServiceNowOptions defines the settings of the servicenow Provider type.
+type ServiceNowOptions struct {
+	// Category is the category of the created incidents.
+	// +optional
+	Category string `json:"category,omitempty"`
+
+	// Urgency overrides the urgency of the created incidents, which
+	// defaults to 1 (High).
+	// +kubebuilder:validation:Enum="1";"2";"3"
+	// +optional
+	Urgency string `json:"urgency,omitempty"`
+
+	// Impact overrides the impact of the created incidents, which
+	// defaults to 2 (Medium).
+	// +kubebuilder:validation:Enum="1";"2";"3"
+	// +optional
+	Impact string `json:"impact,omitempty"`
+
+	// CloseCode is the resolution code of the resolved incidents,
+	// 'Resolved by caller' by default.
+	// +optional
+	CloseCode string `json:"closeCode,omitempty"`
+}
+
+// BatchOptions defines when the batches of events are sent.
+type BatchOptions struct {
+	// Size is the number of events after which a batch is sent, 100 by default.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	Size *int32 `json:"size,omitempty"`
+
+	// FlushInterval is the time after which a batch is sent, 2s by default.
+	// +kubebuilder:validation:Type=string
+	// +kubebuilder:validation:Pattern="^([0-9]+(\\.[0-9]+)?(ms|s|m))+$"
+	// +optional
+	FlushInterval *metav1.Duration `json:"flushInterval,omitempty"`
+}
+
+// SplunkHECOptions defines the settings of the splunkhec Provider type.
+type SplunkHECOptions struct {
+	// Source is the source of the indexed events, 'notification-controller' by default.
+	// +optional
+	Source string `json:"source,omitempty"`
+
+	// SourceType is the sourcetype of the indexed events, 'flux:event' by default.
+	// +optional
+	SourceType string `json:"sourcetype,omitempty"`
+}
+
+// LokiOptions defines the settings of the loki Provider type.
+type LokiOptions struct {
+	// MetadataLabels is the list of event metadata keys added to the
+	// stream labels. Keys with a high number of values like 'revision'
+	// increase the number of streams.
+	// +optional
+	MetadataLabels []string `json:"metadataLabels,omitempty"`
+}
+
+// CloudEventsOptions defines the settings of the cloudevents Provider type.
+type CloudEventsOptions struct {
+	// Mode is the content mode of the emitted CloudEvents, 'structured'
+	// by default. In 'binary' mode, the context attributes are sent as
+	// headers and the data as the body.
+	// +kubebuilder:validation:Enum=structured;binary
+	// +optional
+	Mode string `json:"mode,omitempty"`
+
+	// Format is the format of the data of the emitted CloudEvents, 'flux'
+	// by default. The 'cdevents' format emits CDEvents instead of Flux events.
+	// +kubebuilder:validation:Enum=flux;cdevents
+	// +optional
+	Format string `json:"format,omitempty"`
+}
+
+// AlertmanagerOptions defines the settings of the alertmanager Provider type.
+// +kubebuilder:validation:XValidation:rule="!has(self.resolveAlerts) || !self.resolveAlerts || !has(self.labels) || self.labels.all(k, !k.lowerAscii().endsWith('revision') && !k.lowerAscii().endsWith('token'))", message="spec.alertmanager.labels cannot contain revision or token keys when resolveAlerts is enabled"
+type AlertmanagerOptions struct {
+	// ResolveAlerts enables the alert lifecycle, with an alert per involved
+	// object that is fired by the error events and resolved by the recovery
+	// events.
+	// +optional
+	ResolveAlerts bool `json:"resolveAlerts,omitempty"`
+
+	// Labels is the list of event metadata keys mapped to labels, the
+	// other metadata keys are mapped to annotations. When not set, all
+	// the metadata keys are mapped to labels, unless ResolveAlerts is
+	// enabled. With ResolveAlerts, the labels identify the alert of the
+	// involved object and must have the same value for all its events,
+	// the keys of revisions and tokens are not allowed.
+	// +optional
+	Labels []string `json:"labels,omitempty"`
+}
+
+// SlackOptions defines the settings of the slack Provider type.
+// +kubebuilder:validation:XValidation:rule="!has(self.updateParent) || !self.updateParent || (has(self.threads) && self.threads)", message="spec.slack.updateParent requires spec.slack.threads to be enabled"
+type SlackOptions struct {
+	// Threads groups the messages of an involved object in a thread.
+	// Requires the chat.postMessage API address.
+	// +optional
+	Threads bool `json:"threads,omitempty"`
+
+	// UpdateParent updates the first message of the thread with the
+	// state of the latest event. Requires Threads to be enabled.
+	// +optional
+	UpdateParent bool `json:"updateParent,omitempty"`
+}
+
+// MSTeamsOptions defines the settings of the msteams Provider type.
+type MSTeamsOptions struct {
+	// MetadataKeys is the allowlist of the event metadata keys rendered
+	// as facts. When not set, all the metadata keys are rendered.
+	// +optional
+	MetadataKeys []string `json:"metadataKeys,omitempty"`
+}
+
+// SyslogOptions defines the settings of the syslog Provider type.
+type SyslogOptions struct {
+	// Facility is the facility of the messages, either a keyword like
+	// 'daemon' or a code between 0 and 23, 'local0' by default.
+	// +optional
+	Facility string `json:"facility,omitempty"`
+
+	// Hostname overrides the hostname of the controller in the HOSTNAME
+	// field of the messages.
+	// +optional
+	Hostname string `json:"hostname,omitempty"`
+
+	// AppName overrides the reporting controller of the events in the
+	// APP-NAME field of the messages.
+	// +optional
+	AppName string `json:"appName,omitempty"`
+}
+
+// RedisOptions defines the trim policy of the Redis stream.
+type RedisOptions struct {
+	// MaxLen is the maximum number of entries of the stream, the oldest
+	// entries are evicted when an event is added. By default, the stream
+	// is not trimmed.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	MaxLen int64 `json:"maxLen,omitempty"`
+
+	// ApproximateTrim trims the stream with '~', which is more efficient
+	// but may keep a few more entries than MaxLen.
+	// +optional
+	ApproximateTrim bool `json:"approximateTrim,omitempty"`
 }
 
 // +genclient
@@ -27,6 +27,36 @@ import (
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AMQPOptions) DeepCopyInto(out *AMQPOptions) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AMQPOptions.
+func (in *AMQPOptions) DeepCopy() *AMQPOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(AMQPOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSOptions) DeepCopyInto(out *AWSOptions) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSOptions.
+func (in *AWSOptions) DeepCopy() *AWSOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Alert) DeepCopyInto(out *Alert) {
 	*out = *in
@@ -53,6 +83,23 @@ func (in *Alert) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlertEscalation) DeepCopyInto(out *AlertEscalation) {
+	*out = *in
+	out.After = in.After
+	out.ProviderRef = in.ProviderRef
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertEscalation.
+func (in *AlertEscalation) DeepCopy() *AlertEscalation {
+	if in == nil {
+		return nil
+	}
+	out := new(AlertEscalation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AlertList) DeepCopyInto(out *AlertList) {
 	*out = *in
@@ -85,6 +132,53 @@ func (in *AlertList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlertSchedule) DeepCopyInto(out *AlertSchedule) {
+	*out = *in
+	if in.Windows != nil {
+		in, out := &in.Windows, &out.Windows
+		*out = make([]AlertScheduleWindow, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.RerouteProviderRef != nil {
+		in, out := &in.RerouteProviderRef, &out.RerouteProviderRef
+		*out = new(meta.LocalObjectReference)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertSchedule.
+func (in *AlertSchedule) DeepCopy() *AlertSchedule {
+	if in == nil {
+		return nil
+	}
+	out := new(AlertSchedule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlertScheduleWindow) DeepCopyInto(out *AlertScheduleWindow) {
+	*out = *in
+	if in.Days != nil {
+		in, out := &in.Days, &out.Days
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertScheduleWindow.
+func (in *AlertScheduleWindow) DeepCopy() *AlertScheduleWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(AlertScheduleWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AlertSpec) DeepCopyInto(out *AlertSpec) {
 	*out = *in
@@ -108,11 +202,43 @@ func (in *AlertSpec) DeepCopyInto(out *AlertSpec) {
 			(*out)[key] = val
 		}
 	}
+	if in.InvolvedObjectMetadata != nil {
+		in, out := &in.InvolvedObjectMetadata, &out.InvolvedObjectMetadata
+		*out = new(InvolvedObjectMetadata)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Links != nil {
+		in, out := &in.Links, &out.Links
+		*out = make([]Link, len(*in))
+		copy(*out, *in)
+	}
+	if in.Mentions != nil {
+		in, out := &in.Mentions, &out.Mentions
+		*out = make([]Mention, len(*in))
+		copy(*out, *in)
+	}
 	if in.ExclusionList != nil {
 		in, out := &in.ExclusionList, &out.ExclusionList
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.Escalations != nil {
+		in, out := &in.Escalations, &out.Escalations
+		*out = make([]AlertEscalation, len(*in))
+		copy(*out, *in)
+	}
+	if in.InhibitionRules != nil {
+		in, out := &in.InhibitionRules, &out.InhibitionRules
+		*out = make([]InhibitionRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Schedule != nil {
+		in, out := &in.Schedule, &out.Schedule
+		*out = new(AlertSchedule)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertSpec.
@@ -125,6 +251,252 @@ func (in *AlertSpec) DeepCopy() *AlertSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlertmanagerOptions) DeepCopyInto(out *AlertmanagerOptions) {
+	*out = *in
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertmanagerOptions.
+func (in *AlertmanagerOptions) DeepCopy() *AlertmanagerOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(AlertmanagerOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BatchOptions) DeepCopyInto(out *BatchOptions) {
+	*out = *in
+	if in.Size != nil {
+		in, out := &in.Size, &out.Size
+		*out = new(int32)
+		**out = **in
+	}
+	if in.FlushInterval != nil {
+		in, out := &in.FlushInterval, &out.FlushInterval
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BatchOptions.
+func (in *BatchOptions) DeepCopy() *BatchOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(BatchOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CloudEventsOptions) DeepCopyInto(out *CloudEventsOptions) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudEventsOptions.
+func (in *CloudEventsOptions) DeepCopy() *CloudEventsOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(CloudEventsOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EmailOptions) DeepCopyInto(out *EmailOptions) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EmailOptions.
+func (in *EmailOptions) DeepCopy() *EmailOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(EmailOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InhibitionRule) DeepCopyInto(out *InhibitionRule) {
+	*out = *in
+	in.Source.DeepCopyInto(&out.Source)
+	if in.TargetKinds != nil {
+		in, out := &in.TargetKinds, &out.TargetKinds
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.TargetReasons != nil {
+		in, out := &in.TargetReasons, &out.TargetReasons
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InhibitionRule.
+func (in *InhibitionRule) DeepCopy() *InhibitionRule {
+	if in == nil {
+		return nil
+	}
+	out := new(InhibitionRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InvolvedObjectMetadata) DeepCopyInto(out *InvolvedObjectMetadata) {
+	*out = *in
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InvolvedObjectMetadata.
+func (in *InvolvedObjectMetadata) DeepCopy() *InvolvedObjectMetadata {
+	if in == nil {
+		return nil
+	}
+	out := new(InvolvedObjectMetadata)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JiraOptions) DeepCopyInto(out *JiraOptions) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JiraOptions.
+func (in *JiraOptions) DeepCopy() *JiraOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(JiraOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaOptions) DeepCopyInto(out *KafkaOptions) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaOptions.
+func (in *KafkaOptions) DeepCopy() *KafkaOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Link) DeepCopyInto(out *Link) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Link.
+func (in *Link) DeepCopy() *Link {
+	if in == nil {
+		return nil
+	}
+	out := new(Link)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LokiOptions) DeepCopyInto(out *LokiOptions) {
+	*out = *in
+	if in.MetadataLabels != nil {
+		in, out := &in.MetadataLabels, &out.MetadataLabels
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LokiOptions.
+func (in *LokiOptions) DeepCopy() *LokiOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(LokiOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MQTTOptions) DeepCopyInto(out *MQTTOptions) {
+	*out = *in
+	if in.QoS != nil {
+		in, out := &in.QoS, &out.QoS
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MQTTOptions.
+func (in *MQTTOptions) DeepCopy() *MQTTOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(MQTTOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MSTeamsOptions) DeepCopyInto(out *MSTeamsOptions) {
+	*out = *in
+	if in.MetadataKeys != nil {
+		in, out := &in.MetadataKeys, &out.MetadataKeys
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MSTeamsOptions.
+func (in *MSTeamsOptions) DeepCopy() *MSTeamsOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(MSTeamsOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Mention) DeepCopyInto(out *Mention) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Mention.
+func (in *Mention) DeepCopy() *Mention {
+	if in == nil {
+		return nil
+	}
+	out := new(Mention)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Provider) DeepCopyInto(out *Provider) {
 	*out = *in
@@ -211,6 +583,91 @@ func (in *ProviderSpec) DeepCopyInto(out *ProviderSpec) {
 		*out = new(meta.LocalObjectReference)
 		**out = **in
 	}
+	if in.Links != nil {
+		in, out := &in.Links, &out.Links
+		*out = make([]Link, len(*in))
+		copy(*out, *in)
+	}
+	if in.Email != nil {
+		in, out := &in.Email, &out.Email
+		*out = new(EmailOptions)
+		**out = **in
+	}
+	if in.Kafka != nil {
+		in, out := &in.Kafka, &out.Kafka
+		*out = new(KafkaOptions)
+		**out = **in
+	}
+	if in.MQTT != nil {
+		in, out := &in.MQTT, &out.MQTT
+		*out = new(MQTTOptions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AMQP != nil {
+		in, out := &in.AMQP, &out.AMQP
+		*out = new(AMQPOptions)
+		**out = **in
+	}
+	if in.AWS != nil {
+		in, out := &in.AWS, &out.AWS
+		*out = new(AWSOptions)
+		**out = **in
+	}
+	if in.Jira != nil {
+		in, out := &in.Jira, &out.Jira
+		*out = new(JiraOptions)
+		**out = **in
+	}
+	if in.ServiceNow != nil {
+		in, out := &in.ServiceNow, &out.ServiceNow
+		*out = new(ServiceNowOptions)
+		**out = **in
+	}
+	if in.Batch != nil {
+		in, out := &in.Batch, &out.Batch
+		*out = new(BatchOptions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SplunkHEC != nil {
+		in, out := &in.SplunkHEC, &out.SplunkHEC
+		*out = new(SplunkHECOptions)
+		**out = **in
+	}
+	if in.Loki != nil {
+		in, out := &in.Loki, &out.Loki
+		*out = new(LokiOptions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CloudEvents != nil {
+		in, out := &in.CloudEvents, &out.CloudEvents
+		*out = new(CloudEventsOptions)
+		**out = **in
+	}
+	if in.Alertmanager != nil {
+		in, out := &in.Alertmanager, &out.Alertmanager
+		*out = new(AlertmanagerOptions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Slack != nil {
+		in, out := &in.Slack, &out.Slack
+		*out = new(SlackOptions)
+		**out = **in
+	}
+	if in.MSTeams != nil {
+		in, out := &in.MSTeams, &out.MSTeams
+		*out = new(MSTeamsOptions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Syslog != nil {
+		in, out := &in.Syslog, &out.Syslog
+		*out = new(SyslogOptions)
+		**out = **in
+	}
+	if in.Redis != nil {
+		in, out := &in.Redis, &out.Redis
+		*out = new(RedisOptions)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProviderSpec.
@@ -222,3 +679,78 @@ func (in *ProviderSpec) DeepCopy() *ProviderSpec {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RedisOptions) DeepCopyInto(out *RedisOptions) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RedisOptions.
+func (in *RedisOptions) DeepCopy() *RedisOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(RedisOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceNowOptions) DeepCopyInto(out *ServiceNowOptions) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceNowOptions.
+func (in *ServiceNowOptions) DeepCopy() *ServiceNowOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceNowOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SlackOptions) DeepCopyInto(out *SlackOptions) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SlackOptions.
+func (in *SlackOptions) DeepCopy() *SlackOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(SlackOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SplunkHECOptions) DeepCopyInto(out *SplunkHECOptions) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SplunkHECOptions.
+func (in *SplunkHECOptions) DeepCopy() *SplunkHECOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(SplunkHECOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SyslogOptions) DeepCopyInto(out *SyslogOptions) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SyslogOptions.
+func (in *SyslogOptions) DeepCopy() *SyslogOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(SyslogOptions)
+	in.DeepCopyInto(out)
+	return out
+}
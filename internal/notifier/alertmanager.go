@@ -20,26 +20,51 @@ import (
 	"context"
 	"crypto/tls"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/url"
+	"slices"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/hashicorp/go-retryablehttp"
 	"golang.org/x/text/cases"
 	"golang.org/x/text/language"
+	"sigs.k8s.io/controller-runtime/pkg/log"
 
 	eventv1 "github.com/fluxcd/pkg/apis/event/v1beta1"
+	"github.com/fluxcd/pkg/apis/meta"
+
+	apiv1beta3 "github.com/fluxcd/notification-controller/api/v1beta3"
 )
 
 type Alertmanager struct {
-	URL       string
+	// URLs holds the addresses of the Alertmanager replicas.
+	URLs      []string
 	ProxyURL  string
 	TLSConfig *tls.Config
 	Token     string
 	Username  string
 	Password  string
+
+	// ResolveAlerts enables the alert lifecycle, with an alert per
+	// involved object that is resolved by the recovery events.
+	ResolveAlerts bool
+
+	// LabelKeys holds the metadata keys mapped to labels, the other
+	// metadata keys are mapped to annotations. When nil, all the
+	// metadata keys are mapped to labels unless ResolveAlerts is set.
+	LabelKeys []string
 }
 
+// alertmanagerVolatileKeySuffixes holds the suffixes of the metadata keys whose
+// values change between the events of an object, e.g. 'revision' or
+// 'helm.toolkit.fluxcd.io/revision'. With ResolveAlerts, these keys would
+// change the fingerprint of the alert, so that the recovery events
+// wouldn't resolve it.
+var alertmanagerVolatileKeySuffixes = []string{"revision", "token"}
+
 type AlertManagerAlert struct {
 	Status      string            `json:"status"`
 	Labels      map[string]string `json:"labels"`
@@ -76,39 +101,79 @@ func (a *AlertManagerTime) UnmarshalJSON(jsonRepr []byte) error {
 	return nil
 }
 
-func NewAlertmanager(hookURL string, proxyURL string, tlsConfig *tls.Config, token, user, pass string) (*Alertmanager, error) {
-	_, err := url.ParseRequestURI(hookURL)
-	if err != nil {
-		return nil, fmt.Errorf("invalid Alertmanager URL %s: '%w'", hookURL, err)
+// NewAlertmanager validates the comma-separated Alertmanager URLs and returns an Alertmanager object.
+// The options enable the alert lifecycle and set the metadata keys mapped to labels, the keys of
+// revisions and tokens are rejected with the alert lifecycle.
+func NewAlertmanager(hookURL string, proxyURL string, tlsConfig *tls.Config, token, user, pass string,
+	opts *apiv1beta3.AlertmanagerOptions) (*Alertmanager, error) {
+	var urls []string
+	for _, u := range strings.Split(hookURL, ",") {
+		u = strings.TrimSpace(u)
+		if _, err := url.ParseRequestURI(u); err != nil {
+			return nil, fmt.Errorf("invalid Alertmanager URL %s: '%w'", u, err)
+		}
+		urls = append(urls, u)
 	}
 
-	return &Alertmanager{
-		URL:       hookURL,
+	am := &Alertmanager{
+		URLs:      urls,
 		ProxyURL:  proxyURL,
 		Token:     token,
 		Username:  user,
 		Password:  pass,
 		TLSConfig: tlsConfig,
-	}, nil
+	}
+
+	if opts == nil {
+		return am, nil
+	}
+	am.ResolveAlerts = opts.ResolveAlerts
+	if opts.Labels != nil {
+		am.LabelKeys = []string{}
+		for _, key := range opts.Labels {
+			if key = strings.TrimSpace(key); key == "" {
+				continue
+			}
+			if am.ResolveAlerts && isVolatileAlertmanagerKey(key) {
+				return nil, fmt.Errorf("invalid Alertmanager label '%s', the values of revisions and tokens "+
+					"change between the events and can't identify the alerts with resolveAlerts", key)
+			}
+			am.LabelKeys = append(am.LabelKeys, key)
+		}
+	}
+
+	return am, nil
+}
+
+// isVolatileAlertmanagerKey returns true if the values of the metadata key change between
+// the events of an object.
+func isVolatileAlertmanagerKey(key string) bool {
+	key = strings.ToLower(key)
+	return slices.ContainsFunc(alertmanagerVolatileKeySuffixes, func(suffix string) bool {
+		return strings.HasSuffix(key, suffix)
+	})
 }
 
 func (s *Alertmanager) Post(ctx context.Context, event eventv1.Event) error {
+	// Skip progressing events, they don't fire nor resolve alerts.
+	if s.ResolveAlerts && event.HasReason(meta.ProgressingReason) {
+		return nil
+	}
+
 	annotations := make(map[string]string)
 	annotations["message"] = event.Message
 
-	_, ok := event.Metadata["summary"]
-	if ok {
-		annotations["summary"] = event.Metadata["summary"]
-		delete(event.Metadata, "summary")
-	}
-
 	var labels = make(map[string]string)
-	if event.Metadata != nil {
-		labels = event.Metadata
+	for k, v := range event.Metadata {
+		switch {
+		case k == "summary":
+			annotations[k] = v
+		case s.LabelKeys == nil && !s.ResolveAlerts, slices.Contains(s.LabelKeys, k):
+			labels[k] = v
+		default:
+			annotations[k] = v
+		}
 	}
-	labels["alertname"] = "Flux" + event.InvolvedObject.Kind + cases.Title(language.Und).String(event.Reason)
-	labels["severity"] = event.Severity
-	labels["reason"] = event.Reason
 
 	labels["kind"] = event.InvolvedObject.Kind
 	labels["name"] = event.InvolvedObject.Name
@@ -121,21 +186,36 @@ func (s *Alertmanager) Post(ctx context.Context, event eventv1.Event) error {
 	// the alert is cleared after the timeout). Due to
 	// event.InvolvedObject only containing the object reference (namely
 	// the GVKNN) best we can do is leave it unset up to Alertmanager's
-	// default `resolve_timeout`.
+	// default `resolve_timeout`, unless the alerts are resolved by the
+	// recovery events.
 	//
 	// https://prometheus.io/docs/alerting/0.27/configuration/#file-layout-and-global-settings
-	startsAt := AlertManagerTime(event.Timestamp.Time)
+	alert := AlertManagerAlert{
+		Labels:      labels,
+		Annotations: annotations,
+		Status:      "firing",
 
-	payload := []AlertManagerAlert{
-		{
-			Labels:      labels,
-			Annotations: annotations,
-			Status:      "firing",
+		StartsAt: AlertManagerTime(event.Timestamp.Time),
+	}
 
-			StartsAt: startsAt,
-		},
+	if s.ResolveAlerts {
+		// The labels identify the alert of the involved object, so that
+		// Alertmanager computes the same fingerprint for all its events.
+		labels["alertname"] = "Flux" + event.InvolvedObject.Kind + "NotReady"
+		annotations["severity"] = event.Severity
+		annotations["reason"] = event.Reason
+		if event.Severity != eventv1.EventSeverityError {
+			alert.Status = "resolved"
+			alert.EndsAt = alert.StartsAt
+		}
+	} else {
+		labels["alertname"] = "Flux" + event.InvolvedObject.Kind + cases.Title(language.Und).String(event.Reason)
+		labels["severity"] = event.Severity
+		labels["reason"] = event.Reason
 	}
 
+	payload := []AlertManagerAlert{alert}
+
 	var opts []postOption
 	if s.ProxyURL != "" {
 		opts = append(opts, withProxy(s.ProxyURL))
@@ -154,8 +234,33 @@ func (s *Alertmanager) Post(ctx context.Context, event eventv1.Event) error {
 		}))
 	}
 
-	if err := postMessage(ctx, s.URL, payload, opts...); err != nil {
-		return fmt.Errorf("postMessage failed: %w", err)
+	// Post the alert to all the replicas like Prometheus does, the replicas
+	// of a cluster deduplicate the notifications. The event is delivered
+	// if at least one replica received the alert.
+	errs := make([]error, len(s.URLs))
+	var wg sync.WaitGroup
+	for i, u := range s.URLs {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := postMessage(ctx, u, payload, opts...); err != nil {
+				errs[i] = fmt.Errorf("postMessage failed: %w", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	var failed []error
+	for i, err := range errs {
+		if err != nil {
+			failed = append(failed, err)
+			if len(s.URLs) > 1 {
+				log.FromContext(ctx).Error(err, "failed to send alert to Alertmanager replica", "replica", i)
+			}
+		}
+	}
+	if len(failed) == len(s.URLs) {
+		return errors.Join(failed...)
 	}
 
 	return nil
@@ -23,8 +23,16 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	eventv1 "github.com/fluxcd/pkg/apis/event/v1beta1"
+	"github.com/fluxcd/pkg/apis/meta"
+
+	apiv1beta3 "github.com/fluxcd/notification-controller/api/v1beta3"
 )
 
 func TestAlertmanager_Post(t *testing.T) {
@@ -39,9 +47,304 @@ func TestAlertmanager_Post(t *testing.T) {
 	}))
 	defer ts.Close()
 
-	alertmanager, err := NewAlertmanager(ts.URL, "", nil, "", "", "")
+	alertmanager, err := NewAlertmanager(ts.URL, "", nil, "", "", "", nil)
 	g.Expect(err).ToNot(HaveOccurred())
 
 	err = alertmanager.Post(context.TODO(), testEvent())
 	g.Expect(err).ToNot(HaveOccurred())
 }
+
+func TestNewAlertmanager(t *testing.T) {
+	tests := []struct {
+		name              string
+		url               string
+		opts              *apiv1beta3.AlertmanagerOptions
+		expectedErr       string
+		expectedURLs      []string
+		expectedResolve   bool
+		expectedLabelKeys []string
+	}{
+		{
+			name:        "invalid URL",
+			url:         "alertmanager",
+			expectedErr: "invalid Alertmanager URL alertmanager",
+		},
+		{
+			name:        "invalid replica URL",
+			url:         "http://alertmanager-0:9093/api/v2/alerts,alertmanager-1",
+			expectedErr: "invalid Alertmanager URL alertmanager-1",
+		},
+		{
+			name: "revision label with lifecycle",
+			url:  "http://alertmanager:9093/api/v2/alerts",
+			opts: &apiv1beta3.AlertmanagerOptions{
+				ResolveAlerts: true,
+				Labels:        []string{"team", "helm.toolkit.fluxcd.io/revision"},
+			},
+			expectedErr: "invalid Alertmanager label 'helm.toolkit.fluxcd.io/revision'",
+		},
+		{
+			name: "token label with lifecycle",
+			url:  "http://alertmanager:9093/api/v2/alerts",
+			opts: &apiv1beta3.AlertmanagerOptions{
+				ResolveAlerts: true,
+				Labels:        []string{"kustomize.toolkit.fluxcd.io/Token"},
+			},
+			expectedErr: "invalid Alertmanager label 'kustomize.toolkit.fluxcd.io/Token'",
+		},
+		{
+			name:              "revision label without lifecycle",
+			url:               "http://alertmanager:9093/api/v2/alerts",
+			opts:              &apiv1beta3.AlertmanagerOptions{Labels: []string{"revision"}},
+			expectedURLs:      []string{"http://alertmanager:9093/api/v2/alerts"},
+			expectedLabelKeys: []string{"revision"},
+		},
+		{
+			name:         "single URL",
+			url:          "http://alertmanager:9093/api/v2/alerts",
+			expectedURLs: []string{"http://alertmanager:9093/api/v2/alerts"},
+		},
+		{
+			name: "replicas with lifecycle and labels",
+			url:  "http://alertmanager-0:9093/api/v2/alerts, http://alertmanager-1:9093/api/v2/alerts",
+			opts: &apiv1beta3.AlertmanagerOptions{
+				ResolveAlerts: true,
+				Labels:        []string{"team", " env", ""},
+			},
+			expectedURLs:      []string{"http://alertmanager-0:9093/api/v2/alerts", "http://alertmanager-1:9093/api/v2/alerts"},
+			expectedResolve:   true,
+			expectedLabelKeys: []string{"team", "env"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			am, err := NewAlertmanager(tt.url, "", nil, "", "", "", tt.opts)
+			if tt.expectedErr != "" {
+				g.Expect(err).To(MatchError(ContainSubstring(tt.expectedErr)))
+				g.Expect(am).To(BeNil())
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(am.URLs).To(Equal(tt.expectedURLs))
+			g.Expect(am.ResolveAlerts).To(Equal(tt.expectedResolve))
+			g.Expect(am.LabelKeys).To(Equal(tt.expectedLabelKeys))
+		})
+	}
+}
+
+func TestAlertmanager_PostLabels(t *testing.T) {
+	ts := metav1.NewTime(time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC))
+	failure := eventv1.Event{
+		InvolvedObject: corev1.ObjectReference{
+			Kind:      "Kustomization",
+			Namespace: "flux-system",
+			Name:      "apps",
+		},
+		Severity:  eventv1.EventSeverityError,
+		Reason:    "HealthCheckFailed",
+		Message:   "health check failed",
+		Timestamp: ts,
+		Metadata: map[string]string{
+			"summary":  "Cluster apps",
+			"revision": "main@sha1:e1f2a3b4",
+			"team":     "platform",
+		},
+		ReportingController: "kustomize-controller",
+	}
+	recovery := failure
+	recovery.Severity = eventv1.EventSeverityInfo
+	recovery.Reason = "ReconciliationSucceeded"
+	recovery.Message = "Reconciliation finished"
+	progressing := recovery
+	progressing.Reason = meta.ProgressingReason
+
+	tests := []struct {
+		name                string
+		event               eventv1.Event
+		opts                *apiv1beta3.AlertmanagerOptions
+		expectSkip          bool
+		expectedStatus      string
+		expectedEndsAt      bool
+		expectedLabels      map[string]string
+		expectedAnnotations map[string]string
+	}{
+		{
+			name:           "all metadata as labels by default",
+			event:          failure,
+			expectedStatus: "firing",
+			expectedLabels: map[string]string{
+				"alertname":           "FluxKustomizationHealthcheckfailed",
+				"severity":            "error",
+				"reason":              "HealthCheckFailed",
+				"kind":                "Kustomization",
+				"name":                "apps",
+				"namespace":           "flux-system",
+				"reportingcontroller": "kustomize-controller",
+				"revision":            "main@sha1:e1f2a3b4",
+				"team":                "platform",
+			},
+			expectedAnnotations: map[string]string{
+				"message": "health check failed",
+				"summary": "Cluster apps",
+			},
+		},
+		{
+			name:           "metadata keys mapped to labels",
+			event:          failure,
+			opts:           &apiv1beta3.AlertmanagerOptions{Labels: []string{"team"}},
+			expectedStatus: "firing",
+			expectedLabels: map[string]string{
+				"alertname":           "FluxKustomizationHealthcheckfailed",
+				"severity":            "error",
+				"reason":              "HealthCheckFailed",
+				"kind":                "Kustomization",
+				"name":                "apps",
+				"namespace":           "flux-system",
+				"reportingcontroller": "kustomize-controller",
+				"team":                "platform",
+			},
+			expectedAnnotations: map[string]string{
+				"message":  "health check failed",
+				"summary":  "Cluster apps",
+				"revision": "main@sha1:e1f2a3b4",
+			},
+		},
+		{
+			name:           "failure fires the alert of the object",
+			event:          failure,
+			opts:           &apiv1beta3.AlertmanagerOptions{ResolveAlerts: true, Labels: []string{"team"}},
+			expectedStatus: "firing",
+			expectedLabels: map[string]string{
+				"alertname":           "FluxKustomizationNotReady",
+				"kind":                "Kustomization",
+				"name":                "apps",
+				"namespace":           "flux-system",
+				"reportingcontroller": "kustomize-controller",
+				"team":                "platform",
+			},
+			expectedAnnotations: map[string]string{
+				"message":  "health check failed",
+				"summary":  "Cluster apps",
+				"revision": "main@sha1:e1f2a3b4",
+				"severity": "error",
+				"reason":   "HealthCheckFailed",
+			},
+		},
+		{
+			name:           "recovery resolves the alert of the object",
+			event:          recovery,
+			opts:           &apiv1beta3.AlertmanagerOptions{ResolveAlerts: true, Labels: []string{"team"}},
+			expectedStatus: "resolved",
+			expectedEndsAt: true,
+			expectedLabels: map[string]string{
+				"alertname":           "FluxKustomizationNotReady",
+				"kind":                "Kustomization",
+				"name":                "apps",
+				"namespace":           "flux-system",
+				"reportingcontroller": "kustomize-controller",
+				"team":                "platform",
+			},
+			expectedAnnotations: map[string]string{
+				"message":  "Reconciliation finished",
+				"summary":  "Cluster apps",
+				"revision": "main@sha1:e1f2a3b4",
+				"severity": "info",
+				"reason":   "ReconciliationSucceeded",
+			},
+		},
+		{
+			name:       "progressing events are skipped",
+			event:      progressing,
+			opts:       &apiv1beta3.AlertmanagerOptions{ResolveAlerts: true},
+			expectSkip: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			var payload []AlertManagerAlert
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				g.Expect(json.NewDecoder(r.Body).Decode(&payload)).To(Succeed())
+			}))
+			defer srv.Close()
+
+			am, err := NewAlertmanager(srv.URL, "", nil, "", "", "", tt.opts)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(am.Post(context.TODO(), tt.event)).To(Succeed())
+
+			if tt.expectSkip {
+				g.Expect(payload).To(BeNil())
+				return
+			}
+			g.Expect(payload).To(HaveLen(1))
+			g.Expect(payload[0].Status).To(Equal(tt.expectedStatus))
+			g.Expect(payload[0].Labels).To(Equal(tt.expectedLabels))
+			g.Expect(payload[0].Annotations).To(Equal(tt.expectedAnnotations))
+			g.Expect(time.Time(payload[0].StartsAt)).To(BeTemporally("==", ts.Time))
+			if tt.expectedEndsAt {
+				g.Expect(time.Time(payload[0].EndsAt)).To(BeTemporally("==", ts.Time))
+			}
+		})
+	}
+}
+
+func TestAlertmanager_PostReplicas(t *testing.T) {
+	newReplica := func(status int, received *int) *httptest.Server {
+		return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			*received++
+			w.WriteHeader(status)
+		}))
+	}
+
+	t.Run("alert is posted to all replicas", func(t *testing.T) {
+		g := NewWithT(t)
+
+		var received0, received1 int
+		am0 := newReplica(http.StatusOK, &received0)
+		defer am0.Close()
+		am1 := newReplica(http.StatusOK, &received1)
+		defer am1.Close()
+
+		am, err := NewAlertmanager(am0.URL+","+am1.URL, "", nil, "", "", "", nil)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(am.Post(context.TODO(), testEvent())).To(Succeed())
+		g.Expect(received0).To(Equal(1))
+		g.Expect(received1).To(Equal(1))
+	})
+
+	t.Run("a single replica receiving the alert is enough", func(t *testing.T) {
+		g := NewWithT(t)
+
+		var received0, received1 int
+		am0 := newReplica(http.StatusBadRequest, &received0)
+		defer am0.Close()
+		am1 := newReplica(http.StatusOK, &received1)
+		defer am1.Close()
+
+		am, err := NewAlertmanager(am0.URL+","+am1.URL, "", nil, "", "", "", nil)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(am.Post(context.TODO(), testEvent())).To(Succeed())
+		g.Expect(received1).To(Equal(1))
+	})
+
+	t.Run("error when all replicas fail", func(t *testing.T) {
+		g := NewWithT(t)
+
+		var received0, received1 int
+		am0 := newReplica(http.StatusBadRequest, &received0)
+		defer am0.Close()
+		am1 := newReplica(http.StatusBadRequest, &received1)
+		defer am1.Close()
+
+		am, err := NewAlertmanager(am0.URL+","+am1.URL, "", nil, "", "", "", nil)
+		g.Expect(err).ToNot(HaveOccurred())
+		err = am.Post(context.TODO(), testEvent())
+		g.Expect(err).To(HaveOccurred())
+		g.Expect(err.Error()).To(ContainSubstring("request failed with status code 400"))
+	})
+}
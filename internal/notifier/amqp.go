@@ -0,0 +1,185 @@
+/*
+Copyright 2026 The Flux authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notifier
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+	"text/template"
+	"time"
+
+	eventv1 "github.com/fluxcd/pkg/apis/event/v1beta1"
+	amqp "github.com/rabbitmq/amqp091-go"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	apiv1beta3 "github.com/fluxcd/notification-controller/api/v1beta3"
+)
+
+// amqpDefaultRoutingKey is the routing key template used when the
+// .spec.amqp.routingKey field is not set.
+const amqpDefaultRoutingKey = "flux.{{ .Severity }}.{{ .InvolvedObject.Kind }}.{{ .InvolvedObject.Namespace }}.{{ .InvolvedObject.Name }}"
+
+type (
+	// AMQP holds an AMQP client, the target exchange and the routing key template.
+	AMQP struct {
+		exchange   string
+		routingKey *template.Template
+		client     interface {
+			publish(ctx context.Context, exchange, routingKey string, payload []byte) error
+		}
+	}
+
+	amqpClient struct {
+		url       string
+		username  string
+		password  string
+		tlsConfig *tls.Config
+	}
+)
+
+// NewAMQP creates a new AMQP notifier.
+//
+// Parameters:
+//   - url: AMQP server URL (e.g., "amqp://rabbitmq:5672/vhost" or "amqps://rabbitmq:5671/vhost")
+//   - exchange: the exchange to publish events to, the default exchange is used when empty
+//   - username: Username for authentication (optional)
+//   - password: Password for authentication (optional)
+//   - tlsConfig: TLS configuration used for amqps URLs (optional)
+//   - opts: the Go template of the routing key, overriding the default one (optional)
+//
+// Returns an error if url is empty or the routing key template is invalid.
+func NewAMQP(url string, exchange string, username string, password string,
+	tlsConfig *tls.Config, opts *apiv1beta3.AMQPOptions) (*AMQP, error) {
+	if url == "" {
+		return nil, errors.New("AMQP server URL (address) cannot be empty")
+	}
+	if _, err := amqp.ParseURI(url); err != nil {
+		return nil, fmt.Errorf("invalid AMQP server URL (address): %w", err)
+	}
+
+	routingKey := amqpDefaultRoutingKey
+	if opts != nil && opts.RoutingKey != "" {
+		routingKey = strings.TrimSpace(opts.RoutingKey)
+	}
+	tmpl, err := template.New("routingKey").Option("missingkey=zero").Parse(routingKey)
+	if err != nil {
+		return nil, fmt.Errorf("invalid AMQP routing key template: %w", err)
+	}
+
+	return &AMQP{
+		exchange:   exchange,
+		routingKey: tmpl,
+		client: &amqpClient{
+			url:       url,
+			username:  username,
+			password:  password,
+			tlsConfig: tlsConfig,
+		},
+	}, nil
+}
+
+// Post publishes Flux events to the AMQP exchange with the routing key rendered for the event.
+func (a *AMQP) Post(ctx context.Context, event eventv1.Event) error {
+	var sb strings.Builder
+	if err := a.routingKey.Execute(&sb, event); err != nil {
+		return fmt.Errorf("error rendering routing key: %w", err)
+	}
+	routingKey := sb.String()
+
+	eventPayload, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("error json-marshaling event: %w", err)
+	}
+
+	if err := a.client.publish(ctx, a.exchange, routingKey, eventPayload); err != nil {
+		return fmt.Errorf("error publishing event to exchange '%s' with routing key '%s': %w",
+			a.exchange, routingKey, err)
+	}
+
+	// debug log
+	log.FromContext(ctx).V(1).Info("Event published to AMQP exchange",
+		"exchange", a.exchange, "routingKey", routingKey)
+
+	return nil
+}
+
+// publish publishes the payload with publisher confirms, and returns
+// an error if the server doesn't acknowledge the message.
+func (a *amqpClient) publish(ctx context.Context, exchange, routingKey string, payload []byte) error {
+	config := amqp.Config{
+		TLSClientConfig: a.tlsConfig,
+		Dial: func(network, addr string) (net.Conn, error) {
+			var d net.Dialer
+			conn, err := d.DialContext(ctx, network, addr)
+			if err != nil {
+				return nil, err
+			}
+			if deadline, ok := ctx.Deadline(); ok {
+				if err := conn.SetDeadline(deadline); err != nil {
+					conn.Close()
+					return nil, err
+				}
+			}
+			return conn, nil
+		},
+	}
+	if a.username != "" {
+		config.SASL = []amqp.Authentication{&amqp.PlainAuth{Username: a.username, Password: a.password}}
+	}
+
+	conn, err := amqp.DialConfig(a.url, config)
+	if err != nil {
+		return fmt.Errorf("error connecting to server: %w", err)
+	}
+	defer conn.Close()
+
+	ch, err := conn.Channel()
+	if err != nil {
+		return fmt.Errorf("error opening channel: %w", err)
+	}
+	defer ch.Close()
+
+	if err := ch.Confirm(false); err != nil {
+		return fmt.Errorf("error enabling publisher confirms: %w", err)
+	}
+
+	confirmation, err := ch.PublishWithDeferredConfirmWithContext(ctx, exchange, routingKey, false, false, amqp.Publishing{
+		ContentType:  "application/json",
+		DeliveryMode: amqp.Persistent,
+		Timestamp:    time.Now(),
+		AppId:        "notification-controller",
+		Body:         payload,
+	})
+	if err != nil {
+		return fmt.Errorf("error publishing message: %w", err)
+	}
+
+	acked, err := confirmation.WaitContext(ctx)
+	if err != nil {
+		return fmt.Errorf("error waiting for publisher confirm: %w", err)
+	}
+	if !acked {
+		return errors.New("message was not acknowledged by the server")
+	}
+
+	return nil
+}
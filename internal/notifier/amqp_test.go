@@ -0,0 +1,149 @@
+/*
+Copyright 2026 The Flux authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notifier
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+
+	eventv1 "github.com/fluxcd/pkg/apis/event/v1beta1"
+
+	apiv1beta3 "github.com/fluxcd/notification-controller/api/v1beta3"
+)
+
+func TestNewAMQP(t *testing.T) {
+	tests := []struct {
+		name        string
+		url         string
+		opts        *apiv1beta3.AMQPOptions
+		expectedErr string
+	}{
+		{
+			name:        "empty URL is not allowed",
+			expectedErr: "AMQP server URL (address) cannot be empty",
+		},
+		{
+			name:        "invalid URL",
+			url:         "http://rabbitmq:5672",
+			expectedErr: "invalid AMQP server URL (address)",
+		},
+		{
+			name:        "invalid routing key template",
+			url:         "amqp://rabbitmq:5672",
+			opts:        &apiv1beta3.AMQPOptions{RoutingKey: "flux.{{ .Severity"},
+			expectedErr: "invalid AMQP routing key template",
+		},
+		{
+			name: "TLS URL",
+			url:  "amqps://rabbitmq:5671/flux",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			provider, err := NewAMQP(tt.url, "flux", "user", "pass", nil, tt.opts)
+			if tt.expectedErr != "" {
+				g.Expect(err).To(MatchError(ContainSubstring(tt.expectedErr)))
+				g.Expect(provider).To(BeNil())
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(provider.exchange).To(Equal("flux"))
+
+			client := provider.client.(*amqpClient)
+			g.Expect(client.url).To(Equal(tt.url))
+			g.Expect(client.username).To(Equal("user"))
+			g.Expect(client.password).To(Equal("pass"))
+		})
+	}
+}
+
+type fakeAMQPClient struct {
+	exchange   string
+	routingKey string
+	payload    string
+	publishErr error
+}
+
+func (f *fakeAMQPClient) publish(ctx context.Context, exchange, routingKey string, payload []byte) error {
+	f.exchange = exchange
+	f.routingKey = routingKey
+	f.payload = string(payload)
+	return f.publishErr
+}
+
+func TestAMQPPost(t *testing.T) {
+	event := eventv1.Event{
+		InvolvedObject: corev1.ObjectReference{
+			Kind:      "Kustomization",
+			Namespace: "flux-system",
+			Name:      "apps",
+		},
+		Severity: eventv1.EventSeverityError,
+		Metadata: map[string]string{"foo": "bar"},
+	}
+
+	tests := []struct {
+		name               string
+		opts               *apiv1beta3.AMQPOptions
+		publishErr         error
+		expectedRoutingKey string
+		expectedErr        string
+	}{
+		{
+			name:               "default routing key",
+			expectedRoutingKey: "flux.error.Kustomization.flux-system.apps",
+		},
+		{
+			name:               "custom routing key",
+			opts:               &apiv1beta3.AMQPOptions{RoutingKey: "incidents.{{ .InvolvedObject.Namespace }}"},
+			expectedRoutingKey: "incidents.flux-system",
+		},
+		{
+			name:        "publish error is wrapped and relayed",
+			publishErr:  errors.New("message was not acknowledged by the server"),
+			expectedErr: "error publishing event to exchange 'flux' with routing key 'flux.error.Kustomization.flux-system.apps': message was not acknowledged by the server",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			a, err := NewAMQP("amqp://rabbitmq:5672", "flux", "", "", nil, tt.opts)
+			g.Expect(err).ToNot(HaveOccurred())
+			client := &fakeAMQPClient{publishErr: tt.publishErr}
+			a.client = client
+
+			err = a.Post(context.TODO(), event)
+			if tt.expectedErr != "" {
+				g.Expect(err).To(MatchError(tt.expectedErr))
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(client.exchange).To(Equal("flux"))
+			g.Expect(client.routingKey).To(Equal(tt.expectedRoutingKey))
+			g.Expect(client.payload).To(ContainSubstring(`"metadata":{"foo":"bar"}`))
+		})
+	}
+}
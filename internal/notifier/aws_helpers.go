@@ -0,0 +1,146 @@
+/*
+Copyright 2026 The Flux authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notifier
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+
+	eventv1 "github.com/fluxcd/pkg/apis/event/v1beta1"
+	"github.com/fluxcd/pkg/auth"
+	authaws "github.com/fluxcd/pkg/auth/aws"
+	"github.com/fluxcd/pkg/cache"
+
+	"github.com/fluxcd/notification-controller/api/v1beta3"
+)
+
+// awsClientOptions holds the settings shared by the AWS service clients.
+type awsClientOptions struct {
+	region      string
+	endpoint    string
+	credentials aws.CredentialsProvider
+	httpClient  *http.Client
+}
+
+// buildAWSClientOptions builds client options for AWS services.
+// The region is read from the AWS options of the Provider spec, falling
+// back to the region of the target resource. The endpoint of the options
+// overrides the service endpoint, e.g. for a local stand-in.
+// Authentication precedence: static keys take priority over workload identity.
+func buildAWSClientOptions(ctx context.Context, opts notifierOptions, resourceRegion string) (*awsClientOptions, error) {
+	o := &awsClientOptions{
+		region: resourceRegion,
+	}
+	if spec := opts.ProviderSpec.AWS; spec != nil {
+		if spec.Region != "" {
+			o.region = strings.TrimSpace(spec.Region)
+		}
+		o.endpoint = strings.TrimSpace(spec.Endpoint)
+	}
+	if o.region == "" {
+		return nil, fmt.Errorf("AWS region cannot be determined from '%s', set the region in .spec.aws", opts.URL)
+	}
+	if o.endpoint != "" {
+		if _, err := url.ParseRequestURI(o.endpoint); err != nil {
+			return nil, fmt.Errorf("invalid AWS endpoint '%s': %w", o.endpoint, err)
+		}
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if opts.TLSConfig != nil {
+		transport.TLSClientConfig = opts.TLSConfig
+	}
+	var proxyURL *url.URL
+	if opts.ProxyURL != "" {
+		var err error
+		proxyURL, err = url.Parse(opts.ProxyURL)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing proxy URL: %w", err)
+		}
+		transport.Proxy = http.ProxyURL(proxyURL)
+	}
+	o.httpClient = &http.Client{Transport: transport}
+
+	accessKeyID := strings.TrimSpace(string(opts.SecretData["accessKeyID"]))
+	secretAccessKey := strings.TrimSpace(string(opts.SecretData["secretAccessKey"]))
+	switch {
+	case accessKeyID != "" && secretAccessKey != "":
+		sessionToken := strings.TrimSpace(string(opts.SecretData["sessionToken"]))
+		o.credentials = credentials.NewStaticCredentialsProvider(accessKeyID, secretAccessKey, sessionToken)
+	case accessKeyID != "" || secretAccessKey != "":
+		return nil, errors.New("both accessKeyID and secretAccessKey must be set in the secret")
+	default:
+		authOpts := []auth.Option{
+			auth.WithClient(opts.TokenClient),
+			auth.WithServiceAccountNamespace(opts.ProviderNamespace),
+		}
+
+		if opts.TokenCache != nil {
+			involvedObject := cache.InvolvedObject{
+				Kind:      v1beta3.ProviderKind,
+				Name:      opts.ProviderName,
+				Namespace: opts.ProviderNamespace,
+				Operation: OperationPost,
+			}
+			authOpts = append(authOpts, auth.WithCache(*opts.TokenCache, involvedObject))
+		}
+
+		if opts.ServiceAccountName != "" {
+			authOpts = append(authOpts, auth.WithServiceAccountName(opts.ServiceAccountName))
+		}
+
+		if proxyURL != nil {
+			authOpts = append(authOpts, auth.WithProxyURL(*proxyURL))
+		}
+
+		o.credentials = aws.NewCredentialsCache(authaws.NewCredentialsProvider(ctx, authOpts...))
+	}
+
+	return o, nil
+}
+
+// awsBaseEndpoint returns the endpoint override for the AWS clients,
+// or nil to use the endpoint resolved from the region.
+func awsBaseEndpoint(endpoint string) *string {
+	if endpoint == "" {
+		return nil
+	}
+	return aws.String(endpoint)
+}
+
+// awsMessageGroupID returns the message group of the event for FIFO
+// topics and queues, so that the events of an object are ordered.
+func awsMessageGroupID(event eventv1.Event) string {
+	return fmt.Sprintf("%s/%s/%s", event.InvolvedObject.Kind,
+		event.InvolvedObject.Namespace, event.InvolvedObject.Name)
+}
+
+// awsMessageDeduplicationID returns the deduplication ID of the payload
+// for FIFO topics and queues that don't have content-based deduplication.
+func awsMessageDeduplicationID(payload []byte) string {
+	sum := sha256.Sum256(payload)
+	return hex.EncodeToString(sum[:])
+}
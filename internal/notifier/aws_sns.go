@@ -0,0 +1,115 @@
+/*
+Copyright 2026 The Flux authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notifier
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/sns"
+	snstypes "github.com/aws/aws-sdk-go-v2/service/sns/types"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	eventv1 "github.com/fluxcd/pkg/apis/event/v1beta1"
+)
+
+// AWSSNS holds an AWS SNS client and the target topic ARN.
+type AWSSNS struct {
+	topicARN   string
+	fifo       bool
+	attributes map[string]string
+	client     interface {
+		Publish(ctx context.Context, params *sns.PublishInput, optFns ...func(*sns.Options)) (*sns.PublishOutput, error)
+	}
+}
+
+// ensure *AWSSNS implements Interface.
+var _ Interface = &AWSSNS{}
+
+// NewAWSSNS creates an AWS SNS client for the topic ARN set in the address.
+// The headers are sent as message attributes.
+func NewAWSSNS(opts *notifierOptions) (*AWSSNS, error) {
+	if opts.URL == "" {
+		return nil, errors.New("AWS SNS topic ARN (address) cannot be empty")
+	}
+	topicARN, err := arn.Parse(opts.URL)
+	if err != nil || topicARN.Service != "sns" {
+		return nil, fmt.Errorf("invalid AWS SNS topic ARN (address) '%s'", opts.URL)
+	}
+
+	clientOpts, err := buildAWSClientOptions(opts.Context, *opts, topicARN.Region)
+	if err != nil {
+		return nil, err
+	}
+
+	client := sns.New(sns.Options{
+		Region:       clientOpts.region,
+		Credentials:  clientOpts.credentials,
+		HTTPClient:   clientOpts.httpClient,
+		BaseEndpoint: awsBaseEndpoint(clientOpts.endpoint),
+	})
+
+	return &AWSSNS{
+		topicARN:   opts.URL,
+		fifo:       strings.HasSuffix(topicARN.Resource, ".fifo"),
+		attributes: opts.Headers,
+		client:     client,
+	}, nil
+}
+
+// Post publishes Flux events to an AWS SNS topic.
+func (s *AWSSNS) Post(ctx context.Context, event eventv1.Event) error {
+	eventPayload, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("error json-marshaling event: %w", err)
+	}
+
+	input := &sns.PublishInput{
+		TopicArn: aws.String(s.topicARN),
+		Message:  aws.String(string(eventPayload)),
+	}
+	if len(s.attributes) > 0 {
+		input.MessageAttributes = make(map[string]snstypes.MessageAttributeValue, len(s.attributes))
+		for k, v := range s.attributes {
+			input.MessageAttributes[k] = snstypes.MessageAttributeValue{
+				DataType:    aws.String("String"),
+				StringValue: aws.String(v),
+			}
+		}
+	}
+	if s.fifo {
+		input.MessageGroupId = aws.String(awsMessageGroupID(event))
+		input.MessageDeduplicationId = aws.String(awsMessageDeduplicationID(eventPayload))
+	}
+
+	out, err := s.client.Publish(ctx, input)
+	if err != nil {
+		return fmt.Errorf("error publishing event to AWS SNS topic %s: %w", s.topicARN, err)
+	}
+
+	// debug log
+	log.FromContext(ctx).V(1).Info("Event published to AWS SNS topic",
+		"topic", s.topicARN,
+		"message id", aws.ToString(out.MessageId))
+
+	return nil
+}
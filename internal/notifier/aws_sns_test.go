@@ -0,0 +1,212 @@
+/*
+Copyright 2026 The Flux authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notifier
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sns"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+
+	eventv1 "github.com/fluxcd/pkg/apis/event/v1beta1"
+
+	"github.com/fluxcd/notification-controller/api/v1beta3"
+)
+
+func TestNewAWSSNS(t *testing.T) {
+	staticKeys := map[string][]byte{
+		"accessKeyID":     []byte("AKIDEXAMPLE"),
+		"secretAccessKey": []byte("secret"),
+	}
+
+	tests := []struct {
+		name             string
+		address          string
+		secretData       map[string][]byte
+		aws              *v1beta3.AWSOptions
+		expectedErr      string
+		expectedRegion   string
+		expectedEndpoint *string
+		expectedFIFO     bool
+	}{
+		{
+			name:        "empty topic ARN is not allowed",
+			expectedErr: "AWS SNS topic ARN (address) cannot be empty",
+		},
+		{
+			name:        "invalid topic ARN",
+			address:     "arn:aws:sqs:us-east-1:123456789012:flux",
+			expectedErr: "invalid AWS SNS topic ARN (address) 'arn:aws:sqs:us-east-1:123456789012:flux'",
+		},
+		{
+			name:           "region from topic ARN",
+			address:        "arn:aws:sns:eu-west-1:123456789012:flux",
+			secretData:     staticKeys,
+			expectedRegion: "eu-west-1",
+		},
+		{
+			name:    "region and endpoint from spec",
+			address: "arn:aws:sns:us-east-1:000000000000:flux.fifo",
+			secretData: map[string][]byte{
+				"accessKeyID":     []byte("test"),
+				"secretAccessKey": []byte("test"),
+			},
+			aws: &v1beta3.AWSOptions{
+				Region:   "eu-central-1",
+				Endpoint: "http://localstack:4566",
+			},
+			expectedRegion:   "eu-central-1",
+			expectedEndpoint: aws.String("http://localstack:4566"),
+			expectedFIFO:     true,
+		},
+		{
+			name:           "workload identity without static keys",
+			address:        "arn:aws:sns:us-east-1:123456789012:flux",
+			expectedRegion: "us-east-1",
+		},
+		{
+			name:        "partial static keys are not allowed",
+			address:     "arn:aws:sns:us-east-1:123456789012:flux",
+			secretData:  map[string][]byte{"accessKeyID": []byte("AKIDEXAMPLE")},
+			expectedErr: "both accessKeyID and secretAccessKey must be set in the secret",
+		},
+		{
+			name:        "invalid endpoint",
+			address:     "arn:aws:sns:us-east-1:123456789012:flux",
+			aws:         &v1beta3.AWSOptions{Endpoint: "localstack"},
+			expectedErr: "invalid AWS endpoint 'localstack'",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			provider, err := NewAWSSNS(&notifierOptions{
+				Context:      context.TODO(),
+				URL:          tt.address,
+				SecretData:   tt.secretData,
+				ProviderSpec: v1beta3.ProviderSpec{AWS: tt.aws},
+			})
+			if tt.expectedErr != "" {
+				g.Expect(err).To(MatchError(ContainSubstring(tt.expectedErr)))
+				g.Expect(provider).To(BeNil())
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(provider.topicARN).To(Equal(tt.address))
+			g.Expect(provider.fifo).To(Equal(tt.expectedFIFO))
+
+			options := provider.client.(*sns.Client).Options()
+			g.Expect(options.Region).To(Equal(tt.expectedRegion))
+			g.Expect(options.BaseEndpoint).To(Equal(tt.expectedEndpoint))
+		})
+	}
+}
+
+func TestAWSSNS_Post(t *testing.T) {
+	event := eventv1.Event{
+		InvolvedObject: corev1.ObjectReference{
+			Kind:      "Kustomization",
+			Namespace: "flux-system",
+			Name:      "apps",
+		},
+		Severity: eventv1.EventSeverityError,
+		Metadata: map[string]string{"foo": "bar"},
+	}
+
+	tests := []struct {
+		name        string
+		topic       string
+		status      int
+		expectedErr string
+	}{
+		{
+			name:  "standard topic",
+			topic: "flux",
+		},
+		{
+			name:  "FIFO topic",
+			topic: "flux.fifo",
+		},
+		{
+			name:        "error response is relayed",
+			topic:       "flux",
+			status:      http.StatusNotFound,
+			expectedErr: "error publishing event to AWS SNS topic arn:aws:sns:us-east-1:000000000000:flux",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			topicARN := "arn:aws:sns:us-east-1:000000000000:" + tt.topic
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				g.Expect(r.Header.Get("Authorization")).To(ContainSubstring("Credential=AKIDEXAMPLE/"))
+				g.Expect(r.ParseForm()).To(Succeed())
+				g.Expect(r.Form.Get("Action")).To(Equal("Publish"))
+				g.Expect(r.Form.Get("TopicArn")).To(Equal(topicARN))
+				g.Expect(r.Form.Get("Message")).To(ContainSubstring(`"metadata":{"foo":"bar"}`))
+				g.Expect(r.Form.Get("MessageAttributes.entry.1.Name")).To(Equal("env"))
+				g.Expect(r.Form.Get("MessageAttributes.entry.1.Value.StringValue")).To(Equal("prod"))
+				if tt.topic == "flux.fifo" {
+					g.Expect(r.Form.Get("MessageGroupId")).To(Equal("Kustomization/flux-system/apps"))
+					g.Expect(r.Form.Get("MessageDeduplicationId")).To(HaveLen(64))
+				} else {
+					g.Expect(r.Form.Has("MessageGroupId")).To(BeFalse())
+				}
+
+				w.Header().Set("Content-Type", "text/xml")
+				if tt.status != 0 {
+					w.WriteHeader(tt.status)
+					w.Write([]byte(`<ErrorResponse><Error><Type>Sender</Type><Code>NotFound</Code><Message>Topic does not exist</Message></Error><RequestId>1</RequestId></ErrorResponse>`))
+					return
+				}
+				w.Write([]byte(`<PublishResponse><PublishResult><MessageId>1</MessageId></PublishResult><ResponseMetadata><RequestId>1</RequestId></ResponseMetadata></PublishResponse>`))
+			}))
+			defer ts.Close()
+
+			s, err := NewAWSSNS(&notifierOptions{
+				Context: context.TODO(),
+				URL:     topicARN,
+				Headers: map[string]string{"env": "prod"},
+				SecretData: map[string][]byte{
+					"accessKeyID":     []byte("AKIDEXAMPLE"),
+					"secretAccessKey": []byte("secret"),
+				},
+				ProviderSpec: v1beta3.ProviderSpec{
+					AWS: &v1beta3.AWSOptions{Endpoint: ts.URL},
+				},
+			})
+			g.Expect(err).ToNot(HaveOccurred())
+
+			err = s.Post(context.TODO(), event)
+			if tt.expectedErr != "" {
+				g.Expect(err).To(MatchError(ContainSubstring(tt.expectedErr)))
+				g.Expect(err).To(MatchError(ContainSubstring("Topic does not exist")))
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+		})
+	}
+}
@@ -0,0 +1,132 @@
+/*
+Copyright 2026 The Flux authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notifier
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sqs"
+	sqstypes "github.com/aws/aws-sdk-go-v2/service/sqs/types"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	eventv1 "github.com/fluxcd/pkg/apis/event/v1beta1"
+)
+
+// AWSSQS holds an AWS SQS client and the target queue URL.
+type AWSSQS struct {
+	queueURL   string
+	fifo       bool
+	attributes map[string]string
+	client     interface {
+		SendMessage(ctx context.Context, params *sqs.SendMessageInput, optFns ...func(*sqs.Options)) (*sqs.SendMessageOutput, error)
+	}
+}
+
+// ensure *AWSSQS implements Interface.
+var _ Interface = &AWSSQS{}
+
+// NewAWSSQS creates an AWS SQS client for the queue URL set in the address.
+// The headers are sent as message attributes.
+func NewAWSSQS(opts *notifierOptions) (*AWSSQS, error) {
+	if opts.URL == "" {
+		return nil, errors.New("AWS SQS queue URL (address) cannot be empty")
+	}
+	queueURL, err := url.ParseRequestURI(opts.URL)
+	if err != nil || queueURL.Host == "" {
+		return nil, fmt.Errorf("invalid AWS SQS queue URL (address) '%s'", opts.URL)
+	}
+
+	clientOpts, err := buildAWSClientOptions(opts.Context, *opts, sqsQueueRegion(queueURL.Hostname()))
+	if err != nil {
+		return nil, err
+	}
+
+	client := sqs.New(sqs.Options{
+		Region:       clientOpts.region,
+		Credentials:  clientOpts.credentials,
+		HTTPClient:   clientOpts.httpClient,
+		BaseEndpoint: awsBaseEndpoint(clientOpts.endpoint),
+	})
+
+	return &AWSSQS{
+		queueURL:   opts.URL,
+		fifo:       strings.HasSuffix(queueURL.Path, ".fifo"),
+		attributes: opts.Headers,
+		client:     client,
+	}, nil
+}
+
+// Post sends Flux events to an AWS SQS queue.
+func (s *AWSSQS) Post(ctx context.Context, event eventv1.Event) error {
+	eventPayload, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("error json-marshaling event: %w", err)
+	}
+
+	input := &sqs.SendMessageInput{
+		QueueUrl:    aws.String(s.queueURL),
+		MessageBody: aws.String(string(eventPayload)),
+	}
+	if len(s.attributes) > 0 {
+		input.MessageAttributes = make(map[string]sqstypes.MessageAttributeValue, len(s.attributes))
+		for k, v := range s.attributes {
+			input.MessageAttributes[k] = sqstypes.MessageAttributeValue{
+				DataType:    aws.String("String"),
+				StringValue: aws.String(v),
+			}
+		}
+	}
+	if s.fifo {
+		input.MessageGroupId = aws.String(awsMessageGroupID(event))
+		input.MessageDeduplicationId = aws.String(awsMessageDeduplicationID(eventPayload))
+	}
+
+	out, err := s.client.SendMessage(ctx, input)
+	if err != nil {
+		return fmt.Errorf("error sending event to AWS SQS queue %s: %w", s.queueURL, err)
+	}
+
+	// debug log
+	log.FromContext(ctx).V(1).Info("Event sent to AWS SQS queue",
+		"queue", s.queueURL,
+		"message id", aws.ToString(out.MessageId))
+
+	return nil
+}
+
+// sqsQueueRegion returns the region of a queue URL host, e.g.
+// "sqs.us-east-1.amazonaws.com" or the legacy "us-east-1.queue.amazonaws.com".
+// An empty string is returned for hosts that don't embed a region.
+func sqsQueueRegion(host string) string {
+	parts := strings.Split(host, ".")
+	if len(parts) < 4 {
+		return ""
+	}
+	switch {
+	case parts[0] == "sqs":
+		return parts[1]
+	case parts[1] == "queue":
+		return parts[0]
+	}
+	return ""
+}
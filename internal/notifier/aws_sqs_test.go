@@ -0,0 +1,216 @@
+/*
+Copyright 2026 The Flux authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notifier
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sqs"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+
+	eventv1 "github.com/fluxcd/pkg/apis/event/v1beta1"
+
+	"github.com/fluxcd/notification-controller/api/v1beta3"
+)
+
+func TestNewAWSSQS(t *testing.T) {
+	staticKeys := map[string][]byte{
+		"accessKeyID":     []byte("AKIDEXAMPLE"),
+		"secretAccessKey": []byte("secret"),
+	}
+
+	tests := []struct {
+		name             string
+		address          string
+		secretData       map[string][]byte
+		aws              *v1beta3.AWSOptions
+		expectedErr      string
+		expectedRegion   string
+		expectedEndpoint *string
+		expectedFIFO     bool
+	}{
+		{
+			name:        "empty queue URL is not allowed",
+			expectedErr: "AWS SQS queue URL (address) cannot be empty",
+		},
+		{
+			name:        "invalid queue URL",
+			address:     "flux",
+			expectedErr: "invalid AWS SQS queue URL (address) 'flux'",
+		},
+		{
+			name:           "region from queue URL",
+			address:        "https://sqs.eu-west-1.amazonaws.com/123456789012/flux",
+			secretData:     staticKeys,
+			expectedRegion: "eu-west-1",
+		},
+		{
+			name:           "region from legacy queue URL",
+			address:        "https://ap-southeast-2.queue.amazonaws.com/123456789012/flux.fifo",
+			secretData:     staticKeys,
+			expectedRegion: "ap-southeast-2",
+			expectedFIFO:   true,
+		},
+		{
+			name:        "region is required for custom queue URLs",
+			address:     "http://localstack:4566/000000000000/flux",
+			secretData:  staticKeys,
+			expectedErr: "AWS region cannot be determined from 'http://localstack:4566/000000000000/flux'",
+		},
+		{
+			name:    "region and endpoint from spec",
+			address: "http://localstack:4566/000000000000/flux",
+			aws: &v1beta3.AWSOptions{
+				Region:   "us-east-1",
+				Endpoint: "http://localstack:4566",
+			},
+			expectedRegion:   "us-east-1",
+			expectedEndpoint: aws.String("http://localstack:4566"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			provider, err := NewAWSSQS(&notifierOptions{
+				Context:      context.TODO(),
+				URL:          tt.address,
+				SecretData:   tt.secretData,
+				ProviderSpec: v1beta3.ProviderSpec{AWS: tt.aws},
+			})
+			if tt.expectedErr != "" {
+				g.Expect(err).To(MatchError(ContainSubstring(tt.expectedErr)))
+				g.Expect(provider).To(BeNil())
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(provider.queueURL).To(Equal(tt.address))
+			g.Expect(provider.fifo).To(Equal(tt.expectedFIFO))
+
+			options := provider.client.(*sqs.Client).Options()
+			g.Expect(options.Region).To(Equal(tt.expectedRegion))
+			g.Expect(options.BaseEndpoint).To(Equal(tt.expectedEndpoint))
+		})
+	}
+}
+
+func TestAWSSQS_Post(t *testing.T) {
+	event := eventv1.Event{
+		InvolvedObject: corev1.ObjectReference{
+			Kind:      "Kustomization",
+			Namespace: "flux-system",
+			Name:      "apps",
+		},
+		Severity: eventv1.EventSeverityError,
+		Metadata: map[string]string{"foo": "bar"},
+	}
+
+	tests := []struct {
+		name        string
+		queue       string
+		status      int
+		expectedErr string
+	}{
+		{
+			name:  "standard queue",
+			queue: "flux",
+		},
+		{
+			name:  "FIFO queue",
+			queue: "flux.fifo",
+		},
+		{
+			name:        "error response is relayed",
+			queue:       "flux",
+			status:      http.StatusBadRequest,
+			expectedErr: "error sending event to AWS SQS queue",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			var queueURL string
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				g.Expect(r.Header.Get("Authorization")).To(ContainSubstring("Credential=AKIDEXAMPLE/"))
+				g.Expect(r.Header.Get("X-Amz-Target")).To(Equal("AmazonSQS.SendMessage"))
+
+				var payload struct {
+					QueueUrl               string
+					MessageBody            string
+					MessageGroupId         string
+					MessageDeduplicationId string
+					MessageAttributes      map[string]struct {
+						DataType    string
+						StringValue string
+					}
+				}
+				g.Expect(json.NewDecoder(r.Body).Decode(&payload)).To(Succeed())
+				g.Expect(payload.QueueUrl).To(Equal(queueURL))
+				g.Expect(payload.MessageBody).To(ContainSubstring(`"metadata":{"foo":"bar"}`))
+				g.Expect(payload.MessageAttributes).To(HaveKey("env"))
+				g.Expect(payload.MessageAttributes["env"].StringValue).To(Equal("prod"))
+				if tt.queue == "flux.fifo" {
+					g.Expect(payload.MessageGroupId).To(Equal("Kustomization/flux-system/apps"))
+					g.Expect(payload.MessageDeduplicationId).To(HaveLen(64))
+				} else {
+					g.Expect(payload.MessageGroupId).To(BeEmpty())
+				}
+
+				w.Header().Set("Content-Type", "application/x-amz-json-1.0")
+				if tt.status != 0 {
+					w.WriteHeader(tt.status)
+					w.Write([]byte(`{"__type":"com.amazonaws.sqs#QueueDoesNotExist","message":"The specified queue does not exist."}`))
+					return
+				}
+				w.Write([]byte(`{"MessageId":"1"}`))
+			}))
+			defer ts.Close()
+			queueURL = ts.URL + "/000000000000/" + tt.queue
+
+			s, err := NewAWSSQS(&notifierOptions{
+				Context: context.TODO(),
+				URL:     queueURL,
+				Headers: map[string]string{"env": "prod"},
+				SecretData: map[string][]byte{
+					"accessKeyID":     []byte("AKIDEXAMPLE"),
+					"secretAccessKey": []byte("secret"),
+				},
+				ProviderSpec: v1beta3.ProviderSpec{
+					AWS: &v1beta3.AWSOptions{Region: "us-east-1", Endpoint: ts.URL},
+				},
+			})
+			g.Expect(err).ToNot(HaveOccurred())
+
+			err = s.Post(context.TODO(), event)
+			if tt.expectedErr != "" {
+				g.Expect(err).To(MatchError(ContainSubstring(tt.expectedErr)))
+				g.Expect(err).To(MatchError(ContainSubstring("The specified queue does not exist.")))
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+		})
+	}
+}
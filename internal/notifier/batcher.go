@@ -0,0 +1,133 @@
+/*
+Copyright 2026 The Flux authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notifier
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	apiv1beta3 "github.com/fluxcd/notification-controller/api/v1beta3"
+)
+
+const (
+	// batchDefaultMaxSize is the number of events after which a batch is flushed.
+	batchDefaultMaxSize = 100
+	// batchDefaultFlushInterval is the time after which a batch is flushed.
+	batchDefaultFlushInterval = 2 * time.Second
+	// batchFlushTimeout is the timeout of the request flushing a batch.
+	batchFlushTimeout = 30 * time.Second
+)
+
+// batchFlushFunc sends the items of a batch in a single request.
+type batchFlushFunc func(ctx context.Context, items [][]byte) error
+
+// batchOptions configures when the batches of a provider are flushed.
+type batchOptions struct {
+	maxSize       int
+	flushInterval time.Duration
+}
+
+// eventBatch holds the items collected since the first item of the batch,
+// and the outcome of the flush once done is closed.
+type eventBatch struct {
+	items [][]byte
+	flush batchFlushFunc
+	timer *time.Timer
+	done  chan struct{}
+	err   error
+}
+
+// batches holds the current batch of the providers, notifiers are created
+// for every event while the batches span multiple events. The batch of a
+// provider is removed when it's flushed.
+var batches = struct {
+	sync.Mutex
+	m map[string]*eventBatch
+}{m: map[string]*eventBatch{}}
+
+// newBatchOptions returns the batch options configured with spec.batch
+// of the Provider, the defaults apply to the fields that are not set.
+func newBatchOptions(spec *apiv1beta3.BatchOptions) batchOptions {
+	opts := batchOptions{
+		maxSize:       batchDefaultMaxSize,
+		flushInterval: batchDefaultFlushInterval,
+	}
+	if spec == nil {
+		return opts
+	}
+	if spec.Size != nil && *spec.Size > 0 {
+		opts.maxSize = int(*spec.Size)
+	}
+	if spec.FlushInterval != nil && spec.FlushInterval.Duration > 0 {
+		opts.flushInterval = spec.FlushInterval.Duration
+	}
+	return opts
+}
+
+// addToBatch adds the item to the current batch of the provider and waits
+// for the batch to be flushed, returning the error of the flush request.
+// The flush function replaces the one of the previous calls, so that changes
+// of the provider apply to the next flush. Once the item is added, the batch
+// is flushed within the flush interval and the flush timeout, the wait isn't
+// bound to the context which may expire before the flush interval elapses.
+func addToBatch(ctx context.Context, key string, opts batchOptions, flush batchFlushFunc, item []byte) error {
+	if err := ctx.Err(); err != nil {
+		return fmt.Errorf("error adding event to batch: %w", err)
+	}
+
+	batches.Lock()
+	batch, ok := batches.m[key]
+	if !ok {
+		batch = &eventBatch{done: make(chan struct{})}
+		batches.m[key] = batch
+		batch.timer = time.AfterFunc(opts.flushInterval, func() {
+			flushBatch(key, batch)
+		})
+	}
+	batch.flush = flush
+	batch.items = append(batch.items, item)
+	full := len(batch.items) >= opts.maxSize
+	batches.Unlock()
+
+	if full {
+		go flushBatch(key, batch)
+	}
+
+	<-batch.done
+	return batch.err
+}
+
+// flushBatch removes the batch from the batches of the provider and sends
+// it, the batch is flushed once even if it's full when the flush interval
+// elapses.
+func flushBatch(key string, batch *eventBatch) {
+	batches.Lock()
+	if batches.m[key] != batch {
+		batches.Unlock()
+		return
+	}
+	delete(batches.m, key)
+	batch.timer.Stop()
+	batches.Unlock()
+
+	ctx, cancel := context.WithTimeout(context.Background(), batchFlushTimeout)
+	defer cancel()
+	batch.err = batch.flush(ctx, batch.items)
+	close(batch.done)
+}
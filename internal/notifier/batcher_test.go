@@ -0,0 +1,179 @@
+/*
+Copyright 2026 The Flux authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notifier
+
+import (
+	"context"
+	"errors"
+	"maps"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	apiv1beta3 "github.com/fluxcd/notification-controller/api/v1beta3"
+)
+
+func TestNewBatchOptions(t *testing.T) {
+	tests := []struct {
+		name         string
+		spec         *apiv1beta3.BatchOptions
+		expectedOpts batchOptions
+	}{
+		{
+			name:         "defaults",
+			expectedOpts: batchOptions{maxSize: 100, flushInterval: 2 * time.Second},
+		},
+		{
+			name: "batch size and flush interval",
+			spec: &apiv1beta3.BatchOptions{
+				Size:          ptr.To[int32](500),
+				FlushInterval: &metav1.Duration{Duration: 5 * time.Second},
+			},
+			expectedOpts: batchOptions{maxSize: 500, flushInterval: 5 * time.Second},
+		},
+		{
+			name:         "flush interval only",
+			spec:         &apiv1beta3.BatchOptions{FlushInterval: &metav1.Duration{Duration: 500 * time.Millisecond}},
+			expectedOpts: batchOptions{maxSize: 100, flushInterval: 500 * time.Millisecond},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			g.Expect(newBatchOptions(tt.spec)).To(Equal(tt.expectedOpts))
+		})
+	}
+}
+
+// batchRecorder records the batches it flushes.
+type batchRecorder struct {
+	mu      sync.Mutex
+	batches [][]string
+	err     error
+}
+
+func (r *batchRecorder) flush(ctx context.Context, items [][]byte) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var batch []string
+	for _, item := range items {
+		batch = append(batch, string(item))
+	}
+	r.batches = append(r.batches, batch)
+	return r.err
+}
+
+// addConcurrently adds the items to the batch of the key, and returns the errors of the calls.
+func addConcurrently(ctx context.Context, key string, opts batchOptions, flush batchFlushFunc, items ...string) []error {
+	errs := make([]error, len(items))
+	var wg sync.WaitGroup
+	for i, item := range items {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs[i] = addToBatch(ctx, key, opts, flush, []byte(item))
+		}()
+	}
+	wg.Wait()
+	return errs
+}
+
+func TestAddToBatch(t *testing.T) {
+	t.Run("flushes when the batch is full", func(t *testing.T) {
+		g := NewWithT(t)
+		r := &batchRecorder{}
+		opts := batchOptions{maxSize: 3, flushInterval: time.Hour}
+
+		errs := addConcurrently(context.TODO(), t.Name(), opts, r.flush, "a", "b", "c")
+		g.Expect(errs).To(HaveEach(BeNil()))
+		g.Expect(r.batches).To(HaveLen(1))
+		g.Expect(r.batches[0]).To(ConsistOf("a", "b", "c"))
+	})
+
+	t.Run("flushes when the interval elapses", func(t *testing.T) {
+		g := NewWithT(t)
+		r := &batchRecorder{}
+		opts := batchOptions{maxSize: 100, flushInterval: 50 * time.Millisecond}
+
+		errs := addConcurrently(context.TODO(), t.Name(), opts, r.flush, "a", "b")
+		g.Expect(errs).To(HaveEach(BeNil()))
+		g.Expect(r.batches).To(HaveLen(1))
+		g.Expect(r.batches[0]).To(ConsistOf("a", "b"))
+
+		errs = addConcurrently(context.TODO(), t.Name(), opts, r.flush, "c")
+		g.Expect(errs).To(HaveEach(BeNil()))
+		g.Expect(r.batches).To(HaveLen(2))
+		g.Expect(r.batches[1]).To(ConsistOf("c"))
+	})
+
+	t.Run("flush error is returned to every event of the batch", func(t *testing.T) {
+		g := NewWithT(t)
+		r := &batchRecorder{err: errors.New("service unavailable")}
+		opts := batchOptions{maxSize: 2, flushInterval: time.Hour}
+
+		errs := addConcurrently(context.TODO(), t.Name(), opts, r.flush, "a", "b")
+		g.Expect(errs).To(HaveEach(MatchError("service unavailable")))
+	})
+
+	t.Run("waits for the flush when the context expires", func(t *testing.T) {
+		g := NewWithT(t)
+		r := &batchRecorder{}
+		opts := batchOptions{maxSize: 100, flushInterval: 50 * time.Millisecond}
+
+		ctx, cancel := context.WithTimeout(context.TODO(), 10*time.Millisecond)
+		defer cancel()
+		g.Expect(addToBatch(ctx, t.Name(), opts, r.flush, []byte("a"))).To(Succeed())
+		g.Expect(r.batches).To(Equal([][]string{{"a"}}))
+	})
+
+	t.Run("returns when the context is done before adding", func(t *testing.T) {
+		g := NewWithT(t)
+		r := &batchRecorder{}
+		opts := batchOptions{maxSize: 100, flushInterval: time.Hour}
+
+		ctx, cancel := context.WithCancel(context.TODO())
+		cancel()
+		err := addToBatch(ctx, t.Name(), opts, r.flush, []byte("a"))
+		g.Expect(err).To(MatchError(ContainSubstring("error adding event to batch: context canceled")))
+		g.Expect(r.batches).To(BeEmpty())
+		g.Expect(batchKeys()).ToNot(ContainElement(t.Name()))
+	})
+
+	t.Run("removes the batch of the provider once flushed", func(t *testing.T) {
+		g := NewWithT(t)
+		r := &batchRecorder{}
+		opts := batchOptions{maxSize: 2, flushInterval: time.Hour}
+
+		errs := addConcurrently(context.TODO(), t.Name(), opts, r.flush, "a", "b")
+		g.Expect(errs).To(HaveEach(BeNil()))
+		g.Expect(batchKeys()).ToNot(ContainElement(t.Name()))
+	})
+}
+
+// batchKeys returns the keys of the providers having a current batch.
+func batchKeys() []string {
+	batches.Lock()
+	defer batches.Unlock()
+	return slices.Collect(maps.Keys(batches.m))
+}
@@ -37,28 +37,14 @@ type postOptions struct {
 	password          string
 	requestModifier   func(*retryablehttp.Request)
 	responseValidator func(*http.Response) error
+	responseBody      any
 }
 
 type postOption func(*postOptions)
 
 func postMessage(ctx context.Context, address string, payload any, opts ...postOption) error {
 	options := &postOptions{
-		// Default validateResponse function verifies that the response status code is 200, 202 or 201.
-		responseValidator: func(resp *http.Response) error {
-			s := resp.StatusCode
-			if 200 <= s && s < 300 {
-				return nil
-			}
-
-			err := fmt.Errorf("request failed with status code %d", s)
-
-			b, bodyErr := io.ReadAll(resp.Body)
-			if bodyErr != nil {
-				return fmt.Errorf("%w: unable to read response body: %w", err, bodyErr)
-			}
-
-			return fmt.Errorf("%w: %s", err, string(b))
-		},
+		responseValidator: validateResponseStatus,
 	}
 
 	for _, o := range opts {
@@ -109,9 +95,33 @@ func postMessage(ctx context.Context, address string, payload any, opts ...postO
 		return fmt.Errorf("request failed: %w", err)
 	}
 
+	if options.responseBody != nil {
+		if err := json.NewDecoder(resp.Body).Decode(options.responseBody); err != nil {
+			return fmt.Errorf("failed to decode response body: %w", err)
+		}
+	}
+
 	return nil
 }
 
+// validateResponseStatus is the default response validator, it verifies
+// that the response status code is 2xx.
+func validateResponseStatus(resp *http.Response) error {
+	s := resp.StatusCode
+	if 200 <= s && s < 300 {
+		return nil
+	}
+
+	err := fmt.Errorf("request failed with status code %d", s)
+
+	b, bodyErr := io.ReadAll(resp.Body)
+	if bodyErr != nil {
+		return fmt.Errorf("%w: unable to read response body: %w", err, bodyErr)
+	}
+
+	return fmt.Errorf("%w: %s", err, string(b))
+}
+
 func withProxy(proxy string) postOption {
 	return func(opts *postOptions) {
 		opts.proxy = proxy
@@ -149,6 +159,13 @@ func withResponseValidator(respValidator func(resp *http.Response) error) postOp
 	}
 }
 
+// withResponseBody decodes the JSON body of a successful response into v.
+func withResponseBody(v any) postOption {
+	return func(opts *postOptions) {
+		opts.responseBody = v
+	}
+}
+
 func newHTTPClient(opts *postOptions) (*retryablehttp.Client, error) {
 	httpClient := retryablehttp.NewClient()
 
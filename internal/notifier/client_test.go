@@ -165,6 +165,24 @@ func Test_postMessage_responseValidator(t *testing.T) {
 	g.Expect(err).To(MatchError(ContainSubstring("request failed: error: bad request")))
 }
 
+func Test_postMessage_responseBody(t *testing.T) {
+	g := NewWithT(t)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"id":"10001","key":"OPS-1"}`))
+	}))
+	defer ts.Close()
+
+	var resp struct {
+		ID  string `json:"id"`
+		Key string `json:"key"`
+	}
+	err := postMessage(context.Background(), ts.URL, map[string]string{"status": "success"}, withResponseBody(&resp))
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(resp.ID).To(Equal("10001"))
+	g.Expect(resp.Key).To(Equal("OPS-1"))
+}
+
 func testEvent() eventv1.Event {
 	return eventv1.Event{
 		InvolvedObject: corev1.ObjectReference{
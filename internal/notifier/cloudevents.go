@@ -0,0 +1,380 @@
+/*
+Copyright 2026 The Flux authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notifier
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	cdevents "github.com/cdevents/sdk-go/pkg/api"
+	cdevents04 "github.com/cdevents/sdk-go/pkg/api/v04"
+	cloudevents "github.com/cloudevents/sdk-go/v2/event"
+	"github.com/cloudevents/sdk-go/v2/types"
+	eventv1 "github.com/fluxcd/pkg/apis/event/v1beta1"
+	"github.com/fluxcd/pkg/apis/meta"
+	"github.com/hashicorp/go-retryablehttp"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	apiv1beta3 "github.com/fluxcd/notification-controller/api/v1beta3"
+)
+
+const (
+	// cloudEventsKafkaScheme is the scheme of the addresses selecting the Kafka protocol binding.
+	cloudEventsKafkaScheme = "kafka://"
+	// cloudEventsStructuredContentType is the content type of the events in structured mode.
+	cloudEventsStructuredContentType = "application/cloudevents+json"
+	// cloudEventsTypePrefix is the prefix of the type of the events in the Flux format.
+	cloudEventsTypePrefix = "io.fluxcd"
+	// cloudEventsReasonExtension is the extension attribute holding the reason of the event.
+	cloudEventsReasonExtension = "fluxreason"
+
+	cloudEventsModeStructured = "structured"
+	cloudEventsModeBinary     = "binary"
+	cloudEventsFormatFlux     = "flux"
+	cloudEventsFormatCDEvents = "cdevents"
+)
+
+// CloudEvents holds the target and the encoding of the CloudEvents emitted for Flux events.
+type CloudEvents struct {
+	URL       string
+	ProxyURL  string
+	TLSConfig *tls.Config
+	Headers   map[string]string
+	Username  string
+	Password  string
+	Token     string
+	Binary    bool
+	CDEvents  bool
+	kafka     *Kafka
+}
+
+// NewCloudEvents creates a new CloudEvents notifier.
+//
+// Parameters:
+//   - address: HTTP/S URL of the sink, or kafka:// followed by the comma-separated
+//     list of Kafka brokers (e.g., "kafka://kafka-0:9092,kafka-1:9092")
+//   - proxyURL: HTTP/S proxy URL (optional)
+//   - tlsConfig: TLS configuration (optional)
+//   - topic: Kafka topic, required with the Kafka protocol binding
+//   - headers: HTTP headers added to the requests (optional)
+//   - username: Username for basic authentication, or SASL with Kafka (optional)
+//   - password: Password for basic authentication, or SASL with Kafka (optional)
+//   - token: Bearer token used when username is empty (optional)
+//   - kafkaOpts: the settings of the Kafka protocol binding (optional)
+//   - opts: the content mode, structured by default, and the format, flux or cdevents (optional)
+//
+// Returns an error if the address, the mode or the format is invalid.
+func NewCloudEvents(address string, proxyURL string, tlsConfig *tls.Config, topic string, headers map[string]string,
+	username string, password string, token string, kafkaOpts *apiv1beta3.KafkaOptions,
+	opts *apiv1beta3.CloudEventsOptions) (*CloudEvents, error) {
+	c := &CloudEvents{
+		URL:       address,
+		ProxyURL:  proxyURL,
+		TLSConfig: tlsConfig,
+		Headers:   headers,
+		Username:  username,
+		Password:  password,
+		Token:     token,
+	}
+
+	if brokers, ok := strings.CutPrefix(address, cloudEventsKafkaScheme); ok {
+		k, err := NewKafka(brokers, topic, username, password, tlsConfig, kafkaOpts)
+		if err != nil {
+			return nil, err
+		}
+		c.kafka = k
+	} else if _, err := url.ParseRequestURI(address); err != nil {
+		return nil, fmt.Errorf("invalid CloudEvents sink URL (address) %q: %w", address, err)
+	}
+
+	if opts == nil {
+		return c, nil
+	}
+	switch opts.Mode {
+	case "", cloudEventsModeStructured:
+	case cloudEventsModeBinary:
+		c.Binary = true
+	default:
+		return nil, fmt.Errorf("invalid CloudEvents mode '%s', must be one of: %s, %s",
+			opts.Mode, cloudEventsModeStructured, cloudEventsModeBinary)
+	}
+	switch opts.Format {
+	case "", cloudEventsFormatFlux:
+	case cloudEventsFormatCDEvents:
+		c.CDEvents = true
+	default:
+		return nil, fmt.Errorf("invalid CloudEvents format '%s', must be one of: %s, %s",
+			opts.Format, cloudEventsFormatFlux, cloudEventsFormatCDEvents)
+	}
+
+	return c, nil
+}
+
+// Post emits the event as a CloudEvent with the HTTP or Kafka protocol binding.
+func (c *CloudEvents) Post(ctx context.Context, event eventv1.Event) error {
+	var ce *cloudevents.Event
+	var err error
+	if c.CDEvents {
+		ce, err = toCDEventsCloudEvent(event)
+	} else {
+		ce, err = toFluxCloudEvent(event)
+	}
+	if err != nil {
+		return err
+	}
+	// Not all Flux events have a CDEvents equivalent.
+	if ce == nil {
+		return nil
+	}
+
+	payload, contentType, attributes, err := encodeCloudEvent(ce, c.Binary)
+	if err != nil {
+		return err
+	}
+
+	if c.kafka != nil {
+		headers := map[string]string{"content-type": contentType}
+		for name, val := range attributes {
+			headers["ce_"+name] = val
+		}
+		if err := c.kafka.client.produce(ctx, c.kafka.topic, kafkaKey(event), payload, headers); err != nil {
+			return fmt.Errorf("error publishing CloudEvent to topic %s: %w", c.kafka.topic, err)
+		}
+	} else {
+		opts := []postOption{
+			withProxy(c.ProxyURL),
+			withTLSConfig(c.TLSConfig),
+			withContentType(contentType),
+			withRequestModifier(func(req *retryablehttp.Request) {
+				for name, val := range attributes {
+					req.Header.Set("Ce-"+name, val)
+				}
+				for key, val := range c.Headers {
+					req.Header.Set(key, val)
+				}
+				if c.Username == "" && c.Token != "" {
+					req.Header.Set("Authorization", "Bearer "+c.Token)
+				}
+			}),
+		}
+		if c.Username != "" {
+			opts = append(opts, withBasicAuth(c.Username, c.Password))
+		}
+		if err := postMessage(ctx, c.URL, payload, opts...); err != nil {
+			return fmt.Errorf("error sending CloudEvent: %w", err)
+		}
+	}
+
+	// debug log
+	log.FromContext(ctx).V(1).Info("CloudEvent emitted", "type", ce.Type(), "id", ce.ID())
+
+	return nil
+}
+
+// encodeCloudEvent returns the payload and the content type of the CloudEvent, and in binary
+// mode the context attributes to be sent as headers or record headers.
+func encodeCloudEvent(ce *cloudevents.Event, binary bool) ([]byte, string, map[string]string, error) {
+	if err := ce.Validate(); err != nil {
+		return nil, "", nil, fmt.Errorf("invalid CloudEvent: %w", err)
+	}
+
+	if !binary {
+		payload, err := json.Marshal(ce)
+		if err != nil {
+			return nil, "", nil, fmt.Errorf("error json-marshaling CloudEvent: %w", err)
+		}
+		return payload, cloudEventsStructuredContentType, nil, nil
+	}
+
+	attributes := map[string]string{
+		"specversion": ce.SpecVersion(),
+		"id":          ce.ID(),
+		"source":      ce.Source(),
+		"type":        ce.Type(),
+	}
+	if subject := ce.Subject(); subject != "" {
+		attributes["subject"] = subject
+	}
+	if t := ce.Time(); !t.IsZero() {
+		attributes["time"] = types.FormatTime(t)
+	}
+	for name, val := range ce.Extensions() {
+		s, err := types.Format(val)
+		if err != nil {
+			return nil, "", nil, fmt.Errorf("error formatting CloudEvent extension %s: %w", name, err)
+		}
+		attributes[name] = s
+	}
+	return ce.Data(), ce.DataContentType(), attributes, nil
+}
+
+// cloudEventSource returns the source of the CloudEvents, derived from the reporting controller.
+func cloudEventSource(event eventv1.Event) string {
+	controller := event.ReportingController
+	if controller == "" {
+		controller = "notification-controller"
+	}
+	return "/" + controller
+}
+
+// cloudEventSubject returns the subject of the CloudEvents, derived from the involved object.
+func cloudEventSubject(event eventv1.Event) string {
+	return fmt.Sprintf("%s/%s/%s", event.InvolvedObject.Kind,
+		event.InvolvedObject.Namespace, event.InvolvedObject.Name)
+}
+
+// cloudEventID returns an ID that is the same for the retries of an event.
+func cloudEventID(event eventv1.Event) (string, error) {
+	b, err := json.Marshal(event)
+	if err != nil {
+		return "", fmt.Errorf("error json-marshaling event: %w", err)
+	}
+	return sha1String(string(b)), nil
+}
+
+func cloudEventTime(event eventv1.Event) time.Time {
+	if event.Timestamp.IsZero() {
+		return time.Now()
+	}
+	return event.Timestamp.Time
+}
+
+// toFluxCloudEvent returns a CloudEvent with the Flux event as data, and
+// the type derived from the kind of the involved object and the severity,
+// e.g. io.fluxcd.kustomization.error.
+func toFluxCloudEvent(event eventv1.Event) (*cloudevents.Event, error) {
+	id, err := cloudEventID(event)
+	if err != nil {
+		return nil, err
+	}
+
+	ce := cloudevents.New()
+	ce.SetID(id)
+	ce.SetSource(cloudEventSource(event))
+	ce.SetType(fmt.Sprintf("%s.%s.%s", cloudEventsTypePrefix,
+		strings.ToLower(event.InvolvedObject.Kind), event.Severity))
+	ce.SetSubject(cloudEventSubject(event))
+	ce.SetTime(cloudEventTime(event))
+	if event.Reason != "" {
+		ce.SetExtension(cloudEventsReasonExtension, event.Reason)
+	}
+	if err := ce.SetData(cloudevents.ApplicationJSON, event); err != nil {
+		return nil, fmt.Errorf("error setting CloudEvent data: %w", err)
+	}
+	return &ce, nil
+}
+
+// toCDEventsCloudEvent returns a CloudEvent with the CDEvent mapped from the Flux event,
+// or nil when the event doesn't have a CDEvents equivalent:
+//   - error events are mapped to incident.detected
+//   - the install, upgrade, rollback and uninstall events of HelmReleases are
+//     mapped to service.deployed, service.upgraded, service.rolledback and service.removed
+//   - the other info events are mapped to environment.modified
+//   - events with the Progressing reason are skipped
+func toCDEventsCloudEvent(event eventv1.Event) (*cloudevents.Event, error) {
+	if event.HasReason(meta.ProgressingReason) {
+		return nil, nil
+	}
+
+	id, err := cloudEventID(event)
+	if err != nil {
+		return nil, err
+	}
+	source := cloudEventSource(event)
+	subject := cloudEventSubject(event)
+	environment := &cdevents.Reference{Id: event.InvolvedObject.Namespace, Source: source}
+	artifact := cloudEventArtifactID(event)
+
+	var cd cdevents.CDEventV04
+	switch {
+	case event.Severity == eventv1.EventSeverityError:
+		e, err := cdevents04.NewIncidentDetectedEvent()
+		if err != nil {
+			return nil, err
+		}
+		e.SetSubjectDescription(event.Message)
+		e.SetSubjectEnvironment(environment)
+		e.SetSubjectService(&cdevents.Reference{Id: subject, Source: source})
+		e.SetSubjectArtifactId(artifact)
+		cd = e
+	case event.InvolvedObject.Kind == "HelmRelease" && event.Reason == "InstallSucceeded":
+		e, err := cdevents04.NewServiceDeployedEvent()
+		if err != nil {
+			return nil, err
+		}
+		e.SetSubjectEnvironment(environment)
+		e.SetSubjectArtifactId(artifact)
+		cd = e
+	case event.InvolvedObject.Kind == "HelmRelease" && event.Reason == "UpgradeSucceeded":
+		e, err := cdevents04.NewServiceUpgradedEvent()
+		if err != nil {
+			return nil, err
+		}
+		e.SetSubjectEnvironment(environment)
+		e.SetSubjectArtifactId(artifact)
+		cd = e
+	case event.InvolvedObject.Kind == "HelmRelease" && event.Reason == "RollbackSucceeded":
+		e, err := cdevents04.NewServiceRolledbackEvent()
+		if err != nil {
+			return nil, err
+		}
+		e.SetSubjectEnvironment(environment)
+		e.SetSubjectArtifactId(artifact)
+		cd = e
+	case event.InvolvedObject.Kind == "HelmRelease" && event.Reason == "UninstallSucceeded":
+		e, err := cdevents04.NewServiceRemovedEvent()
+		if err != nil {
+			return nil, err
+		}
+		e.SetSubjectEnvironment(environment)
+		cd = e
+	default:
+		e, err := cdevents04.NewEnvironmentModifiedEvent()
+		if err != nil {
+			return nil, err
+		}
+		e.SetSubjectName(event.InvolvedObject.Name)
+		cd = e
+	}
+
+	cd.SetId(id)
+	cd.SetSource(source)
+	cd.SetSubjectId(subject)
+	cd.SetSubjectSource(source)
+	cd.SetTimestamp(cloudEventTime(event))
+
+	ce, err := cdevents.AsCloudEvent(cd)
+	if err != nil {
+		return nil, fmt.Errorf("error converting event to CDEvent: %w", err)
+	}
+	ce.SetTime(cloudEventTime(event))
+	return ce, nil
+}
+
+// cloudEventArtifactID returns the package URL of the involved object, with the revision of the event as version.
+func cloudEventArtifactID(event eventv1.Event) string {
+	purl := "pkg:generic/" + url.PathEscape(event.InvolvedObject.Name)
+	if revision := event.Metadata[eventv1.MetaRevisionKey]; revision != "" {
+		purl += "@" + strings.ReplaceAll(url.PathEscape(revision), "@", "%40")
+	}
+	return purl
+}
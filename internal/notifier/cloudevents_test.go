@@ -0,0 +1,319 @@
+/*
+Copyright 2026 The Flux authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notifier
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	eventv1 "github.com/fluxcd/pkg/apis/event/v1beta1"
+	"github.com/fluxcd/pkg/apis/meta"
+
+	apiv1beta3 "github.com/fluxcd/notification-controller/api/v1beta3"
+)
+
+func testCloudEventsEvent() eventv1.Event {
+	return eventv1.Event{
+		InvolvedObject: corev1.ObjectReference{
+			Kind:      "HelmRelease",
+			Namespace: "apps",
+			Name:      "podinfo",
+		},
+		Severity:            eventv1.EventSeverityInfo,
+		Reason:              "UpgradeSucceeded",
+		Message:             "Helm upgrade succeeded",
+		Timestamp:           metav1.NewTime(time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)),
+		Metadata:            map[string]string{"revision": "6.5.0"},
+		ReportingController: "helm-controller",
+	}
+}
+
+func TestNewCloudEvents(t *testing.T) {
+	tests := []struct {
+		name          string
+		address       string
+		topic         string
+		opts          *apiv1beta3.CloudEventsOptions
+		expectedErr   string
+		expectedKafka bool
+	}{
+		{
+			name:        "invalid address",
+			address:     "broker",
+			expectedErr: "invalid CloudEvents sink URL (address)",
+		},
+		{
+			name:        "Kafka topic is required",
+			address:     "kafka://kafka:9092",
+			expectedErr: "Kafka topic (channel) cannot be empty",
+		},
+		{
+			name:        "invalid mode",
+			address:     "http://broker-ingress.knative-eventing/default/default",
+			opts:        &apiv1beta3.CloudEventsOptions{Mode: "batched"},
+			expectedErr: "invalid CloudEvents mode 'batched', must be one of: structured, binary",
+		},
+		{
+			name:        "invalid format",
+			address:     "http://broker-ingress.knative-eventing/default/default",
+			opts:        &apiv1beta3.CloudEventsOptions{Format: "cdevent"},
+			expectedErr: "invalid CloudEvents format 'cdevent', must be one of: flux, cdevents",
+		},
+		{
+			name:    "HTTP binding",
+			address: "http://broker-ingress.knative-eventing/default/default",
+		},
+		{
+			name:          "Kafka binding",
+			address:       "kafka://kafka-0:9092,kafka-1:9092",
+			topic:         "flux",
+			expectedKafka: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			c, err := NewCloudEvents(tt.address, "", nil, tt.topic, nil, "", "", "", nil, tt.opts)
+			if tt.expectedErr != "" {
+				g.Expect(err).To(MatchError(ContainSubstring(tt.expectedErr)))
+				g.Expect(c).To(BeNil())
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(c.kafka != nil).To(Equal(tt.expectedKafka))
+			if tt.expectedKafka {
+				g.Expect(c.kafka.topic).To(Equal("flux"))
+				g.Expect(c.kafka.client.(*kafkaClient).brokers).To(Equal([]string{"kafka-0:9092", "kafka-1:9092"}))
+			}
+		})
+	}
+}
+
+func TestCloudEvents_Post_HTTP(t *testing.T) {
+	event := testCloudEventsEvent()
+	eventJSON, err := json.Marshal(event)
+	NewWithT(t).Expect(err).ToNot(HaveOccurred())
+
+	t.Run("structured mode", func(t *testing.T) {
+		g := NewWithT(t)
+
+		var body map[string]any
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			g.Expect(r.Header.Get("Content-Type")).To(Equal("application/cloudevents+json"))
+			g.Expect(r.Header.Get("Authorization")).To(Equal("Bearer token"))
+			g.Expect(r.Header.Get("Ce-Id")).To(BeEmpty())
+			g.Expect(json.NewDecoder(r.Body).Decode(&body)).To(Succeed())
+			w.WriteHeader(http.StatusAccepted)
+		}))
+		defer ts.Close()
+
+		c, err := NewCloudEvents(ts.URL, "", nil, "", nil, "", "", "token", nil, nil)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(c.Post(context.TODO(), event)).To(Succeed())
+
+		g.Expect(body).To(HaveKeyWithValue("specversion", "1.0"))
+		g.Expect(body).To(HaveKeyWithValue("id", sha1String(string(eventJSON))))
+		g.Expect(body).To(HaveKeyWithValue("source", "/helm-controller"))
+		g.Expect(body).To(HaveKeyWithValue("type", "io.fluxcd.helmrelease.info"))
+		g.Expect(body).To(HaveKeyWithValue("subject", "HelmRelease/apps/podinfo"))
+		g.Expect(body).To(HaveKeyWithValue("time", "2026-10-18T12:00:00Z"))
+		g.Expect(body).To(HaveKeyWithValue("fluxreason", "UpgradeSucceeded"))
+		g.Expect(body).To(HaveKeyWithValue("datacontenttype", "application/json"))
+		g.Expect(body).To(HaveKeyWithValue("data", HaveKeyWithValue("message", "Helm upgrade succeeded")))
+	})
+
+	t.Run("binary mode", func(t *testing.T) {
+		g := NewWithT(t)
+
+		var header http.Header
+		var body []byte
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			header = r.Header
+			var err error
+			body, err = io.ReadAll(r.Body)
+			g.Expect(err).ToNot(HaveOccurred())
+		}))
+		defer ts.Close()
+
+		c, err := NewCloudEvents(ts.URL, "", nil, "", map[string]string{"X-Tenant": "platform"},
+			"flux", "pass", "", nil, &apiv1beta3.CloudEventsOptions{Mode: "binary"})
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(c.Post(context.TODO(), event)).To(Succeed())
+
+		g.Expect(header.Get("Content-Type")).To(Equal("application/json"))
+		g.Expect(header.Get("Authorization")).To(Equal("Basic " + basicAuth("flux", "pass")))
+		g.Expect(header.Get("X-Tenant")).To(Equal("platform"))
+		g.Expect(header.Get("Ce-Specversion")).To(Equal("1.0"))
+		g.Expect(header.Get("Ce-Id")).To(Equal(sha1String(string(eventJSON))))
+		g.Expect(header.Get("Ce-Source")).To(Equal("/helm-controller"))
+		g.Expect(header.Get("Ce-Type")).To(Equal("io.fluxcd.helmrelease.info"))
+		g.Expect(header.Get("Ce-Subject")).To(Equal("HelmRelease/apps/podinfo"))
+		g.Expect(header.Get("Ce-Time")).To(Equal("2026-10-18T12:00:00Z"))
+		g.Expect(header.Get("Ce-Fluxreason")).To(Equal("UpgradeSucceeded"))
+		g.Expect(body).To(MatchJSON(eventJSON))
+	})
+}
+
+func TestCloudEvents_Post_Kafka(t *testing.T) {
+	event := testCloudEventsEvent()
+
+	t.Run("binary mode", func(t *testing.T) {
+		g := NewWithT(t)
+
+		c, err := NewCloudEvents("kafka://kafka:9092", "", nil, "flux", nil, "", "", "",
+			&apiv1beta3.KafkaOptions{TLS: true}, &apiv1beta3.CloudEventsOptions{Mode: "binary"})
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(c.kafka.client.(*kafkaClient).tlsConfig).ToNot(BeNil())
+		client := &fakeKafkaClient{}
+		c.kafka.client = client
+
+		g.Expect(c.Post(context.TODO(), event)).To(Succeed())
+		g.Expect(client.topic).To(Equal("flux"))
+		g.Expect(client.key).To(Equal("HelmRelease/apps/podinfo"))
+		g.Expect(client.headers).To(HaveKeyWithValue("content-type", "application/json"))
+		g.Expect(client.headers).To(HaveKeyWithValue("ce_specversion", "1.0"))
+		g.Expect(client.headers).To(HaveKeyWithValue("ce_type", "io.fluxcd.helmrelease.info"))
+		g.Expect(client.headers).To(HaveKeyWithValue("ce_source", "/helm-controller"))
+		g.Expect(client.headers).To(HaveKeyWithValue("ce_fluxreason", "UpgradeSucceeded"))
+		g.Expect(client.value).To(ContainSubstring(`"message":"Helm upgrade succeeded"`))
+	})
+
+	t.Run("structured mode with CDEvents", func(t *testing.T) {
+		g := NewWithT(t)
+
+		c, err := NewCloudEvents("kafka://kafka:9092", "", nil, "flux", nil, "", "", "",
+			nil, &apiv1beta3.CloudEventsOptions{Format: "cdevents"})
+		g.Expect(err).ToNot(HaveOccurred())
+		client := &fakeKafkaClient{}
+		c.kafka.client = client
+
+		g.Expect(c.Post(context.TODO(), event)).To(Succeed())
+		g.Expect(client.headers).To(Equal(map[string]string{"content-type": "application/cloudevents+json"}))
+		var ce map[string]any
+		g.Expect(json.Unmarshal([]byte(client.value), &ce)).To(Succeed())
+		g.Expect(ce).To(HaveKeyWithValue("type", "dev.cdevents.service.upgraded.0.2.0"))
+		g.Expect(ce).To(HaveKeyWithValue("data", HaveKeyWithValue("subject", HaveKeyWithValue("content", Equal(map[string]any{
+			"artifactId":  "pkg:generic/podinfo@6.5.0",
+			"environment": map[string]any{"id": "apps", "source": "/helm-controller"},
+		})))))
+	})
+}
+
+func TestToCDEventsCloudEvent(t *testing.T) {
+	tests := []struct {
+		name         string
+		kind         string
+		severity     string
+		reason       string
+		revision     string
+		expectedType string
+	}{
+		{
+			name:         "failures are incidents",
+			kind:         "Kustomization",
+			severity:     eventv1.EventSeverityError,
+			reason:       "HealthCheckFailed",
+			revision:     "main@sha1:e1f2a3b4",
+			expectedType: "dev.cdevents.incident.detected.0.2.0",
+		},
+		{
+			name:         "Helm install",
+			kind:         "HelmRelease",
+			severity:     eventv1.EventSeverityInfo,
+			reason:       "InstallSucceeded",
+			revision:     "6.5.0+a1b2c3",
+			expectedType: "dev.cdevents.service.deployed.0.2.0",
+		},
+		{
+			name:         "Helm rollback",
+			kind:         "HelmRelease",
+			severity:     eventv1.EventSeverityInfo,
+			reason:       "RollbackSucceeded",
+			expectedType: "dev.cdevents.service.rolledback.0.2.0",
+		},
+		{
+			name:         "Helm uninstall",
+			kind:         "HelmRelease",
+			severity:     eventv1.EventSeverityInfo,
+			reason:       "UninstallSucceeded",
+			expectedType: "dev.cdevents.service.removed.0.2.0",
+		},
+		{
+			name:         "other events modify the environment",
+			kind:         "Kustomization",
+			severity:     eventv1.EventSeverityInfo,
+			reason:       "ReconciliationSucceeded",
+			expectedType: "dev.cdevents.environment.modified.0.2.0",
+		},
+		{
+			name:     "progressing events are skipped",
+			kind:     "Kustomization",
+			severity: eventv1.EventSeverityInfo,
+			reason:   meta.ProgressingReason,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			event := testCloudEventsEvent()
+			event.InvolvedObject.Kind = tt.kind
+			event.Severity = tt.severity
+			event.Reason = tt.reason
+			event.Metadata = map[string]string{}
+			if tt.revision != "" {
+				event.Metadata["revision"] = tt.revision
+			}
+
+			ce, err := toCDEventsCloudEvent(event)
+			g.Expect(err).ToNot(HaveOccurred())
+			if tt.expectedType == "" {
+				g.Expect(ce).To(BeNil())
+				return
+			}
+			g.Expect(ce.Type()).To(Equal(tt.expectedType))
+			g.Expect(ce.Source()).To(Equal("/helm-controller"))
+			g.Expect(ce.Subject()).To(Equal(tt.kind + "/apps/podinfo"))
+			g.Expect(ce.Time()).To(Equal(event.Timestamp.Time))
+		})
+	}
+}
+
+func TestCloudEventArtifactID(t *testing.T) {
+	g := NewWithT(t)
+
+	event := testCloudEventsEvent()
+	g.Expect(cloudEventArtifactID(event)).To(Equal("pkg:generic/podinfo@6.5.0"))
+
+	event.Metadata["revision"] = "main@sha1:e1f2a3b4"
+	g.Expect(cloudEventArtifactID(event)).To(Equal("pkg:generic/podinfo@main%40sha1:e1f2a3b4"))
+
+	delete(event.Metadata, "revision")
+	g.Expect(cloudEventArtifactID(event)).To(Equal("pkg:generic/podinfo"))
+}
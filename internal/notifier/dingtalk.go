@@ -0,0 +1,210 @@
+/*
+Copyright 2026 The Flux authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notifier
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/tls"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	eventv1 "github.com/fluxcd/pkg/apis/event/v1beta1"
+
+	apiv1beta3 "github.com/fluxcd/notification-controller/api/v1beta3"
+)
+
+// DingTalk holds the robot webhook URL and the signing secret.
+type DingTalk struct {
+	URL           string
+	ProxyURL      string
+	SigningSecret string
+	TLSConfig     *tls.Config
+}
+
+// DingTalkPayload holds the markdown message of the robot.
+// See https://open.dingtalk.com/document/orgapp/custom-robot-access.
+type DingTalkPayload struct {
+	MsgType  string           `json:"msgtype"`
+	Markdown DingTalkMarkdown `json:"markdown"`
+	At       DingTalkAt       `json:"at"`
+}
+
+// DingTalkMarkdown holds the title shown in the notifications and the markdown text.
+type DingTalkMarkdown struct {
+	Title string `json:"title"`
+	Text  string `json:"text"`
+}
+
+// DingTalkAt holds the mobile numbers of the mentioned users.
+type DingTalkAt struct {
+	AtMobiles []string `json:"atMobiles,omitempty"`
+	IsAtAll   bool     `json:"isAtAll"`
+}
+
+// NewDingTalk creates a new DingTalk notifier.
+//
+// Parameters:
+//   - address: robot webhook URL with the access token
+//   - proxyURL: Proxy URL (optional)
+//   - tlsConfig: TLS configuration (optional)
+//   - secretData: the "signingSecret" key sets the secret used to sign
+//     the requests, required when the robot has the signature security setting
+//
+// Returns an error if the address is invalid.
+func NewDingTalk(address string, proxyURL string, tlsConfig *tls.Config, secretData map[string][]byte) (*DingTalk, error) {
+	if _, err := url.ParseRequestURI(address); err != nil {
+		return nil, fmt.Errorf("invalid DingTalk webhook URL (address) %q: %w", address, err)
+	}
+
+	return &DingTalk{
+		URL:           address,
+		ProxyURL:      proxyURL,
+		SigningSecret: strings.TrimSpace(string(secretData["signingSecret"])),
+		TLSConfig:     tlsConfig,
+	}, nil
+}
+
+// Post sends the event as a markdown message, mentioning the users of the
+// Alert mentions by mobile number.
+func (d *DingTalk) Post(ctx context.Context, event eventv1.Event) error {
+	title, text := formatChatBotMarkdown(event, GetLinks(ctx))
+
+	payload := DingTalkPayload{
+		MsgType: "markdown",
+		Markdown: DingTalkMarkdown{
+			Title: title,
+		},
+	}
+	// The mobile numbers must also be in the text for the mentions to be shown.
+	var mentions []string
+	for _, m := range GetMentions(ctx) {
+		if m.Type == apiv1beta3.MentionTag {
+			continue
+		}
+		payload.At.AtMobiles = append(payload.At.AtMobiles, m.ID)
+		mentions = append(mentions, "@"+m.ID)
+	}
+	if len(mentions) > 0 {
+		text += "\n\n" + strings.Join(mentions, " ")
+	}
+	payload.Markdown.Text = text
+
+	address := d.URL
+	if d.SigningSecret != "" {
+		u, err := url.Parse(d.URL)
+		if err != nil {
+			return fmt.Errorf("failed to parse webhook URL: %w", err)
+		}
+		timestamp := time.Now().UnixMilli()
+		q := u.Query()
+		q.Set("timestamp", strconv.FormatInt(timestamp, 10))
+		q.Set("sign", dingTalkSign(d.SigningSecret, timestamp))
+		u.RawQuery = q.Encode()
+		address = u.String()
+	}
+
+	opts := []postOption{withResponseValidator(validateChatBotResponse)}
+	if d.ProxyURL != "" {
+		opts = append(opts, withProxy(d.ProxyURL))
+	}
+	if d.TLSConfig != nil {
+		opts = append(opts, withTLSConfig(d.TLSConfig))
+	}
+
+	if err := postMessage(ctx, address, payload, opts...); err != nil {
+		return fmt.Errorf("postMessage failed: %w", err)
+	}
+
+	return nil
+}
+
+// dingTalkSign returns the signature of the timestamp in milliseconds,
+// the HMAC-SHA256 of "timestamp\nsecret" keyed with the secret.
+func dingTalkSign(secret string, timestamp int64) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(fmt.Sprintf("%d\n%s", timestamp, secret)))
+	return base64.StdEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// formatChatBotMarkdown returns the title and the markdown text of the
+// event for the chat bots, with the metadata sorted by key and the links.
+func formatChatBotMarkdown(event eventv1.Event, links []Link) (string, string) {
+	emoji := "💫"
+	if event.Severity == eventv1.EventSeverityError {
+		emoji = "🚨"
+	}
+	title := fmt.Sprintf("%s %s/%s.%s", emoji, strings.ToLower(event.InvolvedObject.Kind),
+		event.InvolvedObject.Name, event.InvolvedObject.Namespace)
+
+	keys := make([]string, 0, len(event.Metadata))
+	for k := range event.Metadata {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "#### %s\n\n%s", title, event.Message)
+	for _, k := range keys {
+		fmt.Fprintf(&sb, "\n\n> %s: %s", k, event.Metadata[k])
+	}
+	sb.WriteString(markdownLinks(links))
+	return title, sb.String()
+}
+
+// validateChatBotResponse validates the response of the DingTalk, WeCom
+// and Lark webhooks, which return 200 OK with an error code in the body.
+func validateChatBotResponse(resp *http.Response) error {
+	if err := validateResponseStatus(resp); err != nil {
+		return err
+	}
+
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("unable to read response body: %w", err)
+	}
+	if len(bytes.TrimSpace(b)) == 0 {
+		return nil
+	}
+
+	var botResp struct {
+		ErrCode int    `json:"errcode"`
+		ErrMsg  string `json:"errmsg"`
+		Code    int    `json:"code"`
+		Msg     string `json:"msg"`
+	}
+	if err := json.Unmarshal(b, &botResp); err != nil {
+		return fmt.Errorf("unable to unmarshal response body: %w", err)
+	}
+	if botResp.ErrCode != 0 {
+		return fmt.Errorf("webhook responded with error code %d: %s", botResp.ErrCode, botResp.ErrMsg)
+	}
+	if botResp.Code != 0 {
+		return fmt.Errorf("webhook responded with error code %d: %s", botResp.Code, botResp.Msg)
+	}
+	return nil
+}
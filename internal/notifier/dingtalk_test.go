@@ -0,0 +1,195 @@
+/*
+Copyright 2026 The Flux authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notifier
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+
+	eventv1 "github.com/fluxcd/pkg/apis/event/v1beta1"
+
+	apiv1beta3 "github.com/fluxcd/notification-controller/api/v1beta3"
+)
+
+func TestNewDingTalk(t *testing.T) {
+	g := NewWithT(t)
+
+	_, err := NewDingTalk("dingtalk", "", nil, nil)
+	g.Expect(err).To(MatchError(ContainSubstring("invalid DingTalk webhook URL (address)")))
+
+	d, err := NewDingTalk("https://oapi.dingtalk.com/robot/send?access_token=token", "", nil,
+		map[string][]byte{"signingSecret": []byte(" SEC0123456789 \n")})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(d.SigningSecret).To(Equal("SEC0123456789"))
+}
+
+func TestDingTalk_Post(t *testing.T) {
+	tests := []struct {
+		name          string
+		signingSecret string
+		response      string
+		expectedErr   string
+	}{
+		{
+			name:     "unsigned",
+			response: `{"errcode":0,"errmsg":"ok"}`,
+		},
+		{
+			name:          "signed",
+			signingSecret: "SEC0123456789",
+			response:      `{"errcode":0,"errmsg":"ok"}`,
+		},
+		{
+			name:        "error code is relayed",
+			response:    `{"errcode":310000,"errmsg":"sign not match"}`,
+			expectedErr: "webhook responded with error code 310000: sign not match",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			var payload DingTalkPayload
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				q := r.URL.Query()
+				g.Expect(q.Get("access_token")).To(Equal("token"))
+				if tt.signingSecret == "" {
+					g.Expect(q.Has("sign")).To(BeFalse())
+				} else {
+					timestamp, err := strconv.ParseInt(q.Get("timestamp"), 10, 64)
+					g.Expect(err).ToNot(HaveOccurred())
+					g.Expect(q.Get("sign")).To(Equal(dingTalkSign(tt.signingSecret, timestamp)))
+				}
+				g.Expect(json.NewDecoder(r.Body).Decode(&payload)).To(Succeed())
+				_, _ = w.Write([]byte(tt.response))
+			}))
+			defer ts.Close()
+
+			d, err := NewDingTalk(ts.URL+"/robot/send?access_token=token", "", nil,
+				map[string][]byte{"signingSecret": []byte(tt.signingSecret)})
+			g.Expect(err).ToNot(HaveOccurred())
+
+			event := testEvent()
+			event.Severity = eventv1.EventSeverityError
+			ctx := WithMentions(context.TODO(), []apiv1beta3.Mention{
+				{ID: "13800000000", Name: "Li Lei"},
+				{ID: "oncall", Name: "oncall", Type: apiv1beta3.MentionTag},
+			})
+			err = d.Post(ctx, event)
+			if tt.expectedErr != "" {
+				g.Expect(err).To(MatchError(ContainSubstring(tt.expectedErr)))
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+
+			g.Expect(payload).To(Equal(DingTalkPayload{
+				MsgType: "markdown",
+				Markdown: DingTalkMarkdown{
+					Title: "🚨 gitrepository/webapp.gitops-system",
+					Text:  "#### 🚨 gitrepository/webapp.gitops-system\n\nmessage\n\n> test: metadata\n\n@13800000000",
+				},
+				At: DingTalkAt{AtMobiles: []string{"13800000000"}},
+			}))
+		})
+	}
+}
+
+func TestDingTalk_Sign(t *testing.T) {
+	g := NewWithT(t)
+	// HMAC-SHA256 of "1792324800000\nSEC0123456789" keyed with "SEC0123456789".
+	g.Expect(dingTalkSign("SEC0123456789", 1792324800000)).To(Equal("XlNGk9bBQ6bYCmpShiYRsJnMyMscLjWbxhl2jzkSJPY="))
+}
+
+func TestFormatChatBotMarkdown(t *testing.T) {
+	g := NewWithT(t)
+
+	event := eventv1.Event{
+		InvolvedObject: corev1.ObjectReference{
+			Kind:      "HelmRelease",
+			Namespace: "apps",
+			Name:      "podinfo",
+		},
+		Severity: eventv1.EventSeverityInfo,
+		Message:  "Helm upgrade succeeded",
+		Metadata: map[string]string{"revision": "6.5.4", "cluster": "prod"},
+	}
+	links := []Link{{Name: "Dashboard", URL: "https://flux.example.com"}}
+
+	title, text := formatChatBotMarkdown(event, links)
+	g.Expect(title).To(Equal("💫 helmrelease/podinfo.apps"))
+	g.Expect(text).To(Equal("#### 💫 helmrelease/podinfo.apps\n\nHelm upgrade succeeded\n\n> cluster: prod\n\n> revision: 6.5.4\n\n[Dashboard](https://flux.example.com)"))
+}
+
+func TestValidateChatBotResponse(t *testing.T) {
+	tests := []struct {
+		name        string
+		status      int
+		body        string
+		expectedErr string
+	}{
+		{
+			name:   "empty body",
+			status: http.StatusOK,
+		},
+		{
+			name:   "DingTalk and WeCom success",
+			status: http.StatusOK,
+			body:   `{"errcode":0,"errmsg":"ok"}`,
+		},
+		{
+			name:   "Lark success",
+			status: http.StatusOK,
+			body:   `{"code":0,"msg":"success","data":{}}`,
+		},
+		{
+			name:        "Lark error",
+			status:      http.StatusOK,
+			body:        `{"code":19021,"msg":"sign match fail or timestamp is not within one hour from current time"}`,
+			expectedErr: "webhook responded with error code 19021: sign match fail",
+		},
+		{
+			name:        "HTTP error",
+			status:      http.StatusBadRequest,
+			body:        `bad request`,
+			expectedErr: "request failed with status code 400: bad request",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			resp := httptest.NewRecorder()
+			resp.WriteHeader(tt.status)
+			_, _ = resp.WriteString(tt.body)
+			err := validateChatBotResponse(resp.Result())
+			if tt.expectedErr != "" {
+				g.Expect(err).To(MatchError(ContainSubstring(tt.expectedErr)))
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+		})
+	}
+}
@@ -78,7 +78,7 @@ func (s *Discord) Post(ctx context.Context, event eventv1.Event) error {
 	a := SlackAttachment{
 		Color:      color,
 		AuthorName: fmt.Sprintf("%s/%s.%s", strings.ToLower(event.InvolvedObject.Kind), event.InvolvedObject.Name, event.InvolvedObject.Namespace),
-		Text:       event.Message,
+		Text:       event.Message + markdownLinks(GetLinks(ctx)),
 		MrkdwnIn:   []string{"text"},
 		Fields:     sfields,
 	}
@@ -49,3 +49,25 @@ func TestDiscord_Post(t *testing.T) {
 	err = discord.Post(context.TODO(), testEvent())
 	g.Expect(err).ToNot(HaveOccurred())
 }
+
+func TestDiscord_PostLinks(t *testing.T) {
+	g := NewWithT(t)
+
+	var payload SlackPayload
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		g.Expect(json.NewDecoder(r.Body).Decode(&payload)).To(Succeed())
+	}))
+	defer ts.Close()
+
+	discord, err := NewDiscord(ts.URL, "", "test", "test")
+	g.Expect(err).ToNot(HaveOccurred())
+
+	ctx := WithLinks(context.TODO(), []Link{
+		{Name: "Dashboard", URL: "https://flux.example.com"},
+		{Name: "Logs", URL: "https://logs.example.com"},
+	})
+	g.Expect(discord.Post(ctx, testEvent())).To(Succeed())
+
+	g.Expect(payload.Attachments).To(HaveLen(1))
+	g.Expect(payload.Attachments[0].Text).To(Equal("message\n\n[Dashboard](https://flux.example.com) | [Logs](https://logs.example.com)"))
+}
@@ -0,0 +1,217 @@
+/*
+Copyright 2026 The Flux authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notifier
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+
+	eventv1 "github.com/fluxcd/pkg/apis/event/v1beta1"
+	"github.com/hashicorp/go-retryablehttp"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	apiv1beta3 "github.com/fluxcd/notification-controller/api/v1beta3"
+)
+
+// elasticsearchDefaultIndex is the index pattern used when the channel is not set.
+const elasticsearchDefaultIndex = "flux-events-%{+yyyy.MM.dd}"
+
+var (
+	// elasticsearchDatePattern matches the date patterns of the index, e.g. %{+yyyy.MM.dd}.
+	elasticsearchDatePattern = regexp.MustCompile(`%\{\+([^}]*)\}`)
+
+	// elasticsearchDateLayout converts the date patterns to Go time layouts.
+	elasticsearchDateLayout = strings.NewReplacer(
+		"yyyy", "2006",
+		"YYYY", "2006",
+		"yy", "06",
+		"MM", "01",
+		"dd", "02",
+		"HH", "15",
+	)
+)
+
+// Elasticsearch holds the cluster URL, the credentials and the index pattern of the events.
+type Elasticsearch struct {
+	URL       string
+	ProxyURL  string
+	TLSConfig *tls.Config
+	Index     string
+	Username  string
+	Password  string
+	APIKey    string
+	batchKey  string
+	batch     batchOptions
+}
+
+// elasticsearchDocument is the document indexed for a Flux event.
+type elasticsearchDocument struct {
+	Timestamp time.Time `json:"@timestamp"`
+	eventv1.Event
+}
+
+// elasticsearchBulkResponse holds the fields of the Bulk API response reporting failures.
+type elasticsearchBulkResponse struct {
+	Errors bool `json:"errors"`
+	Items  []map[string]struct {
+		Status int `json:"status"`
+		Error  *struct {
+			Type   string `json:"type"`
+			Reason string `json:"reason"`
+		} `json:"error,omitempty"`
+	} `json:"items"`
+}
+
+// NewElasticsearch creates a new Elasticsearch notifier.
+//
+// Parameters:
+//   - providerUID: the UID of the Provider, the events of a Provider are sent in batches
+//   - address: Elasticsearch URL (e.g., "https://elasticsearch:9200")
+//   - proxyURL: HTTP/S proxy URL (optional)
+//   - tlsConfig: TLS configuration (optional)
+//   - index: the index of the events, with optional date patterns like %{+yyyy.MM.dd} (optional)
+//   - username: Username for basic authentication (optional)
+//   - password: Password for basic authentication (optional)
+//   - secretData: the "apiKey" key sets the encoded API key used instead of basic authentication
+//   - batch: the size and flush interval of the batches (optional)
+//
+// Returns an error if the address or the index is invalid.
+func NewElasticsearch(providerUID string, address string, proxyURL string, tlsConfig *tls.Config,
+	index string, username string, password string, secretData map[string][]byte,
+	batch *apiv1beta3.BatchOptions) (*Elasticsearch, error) {
+	if _, err := url.ParseRequestURI(address); err != nil {
+		return nil, fmt.Errorf("invalid Elasticsearch URL (address) %q: %w", address, err)
+	}
+
+	if index == "" {
+		index = elasticsearchDefaultIndex
+	}
+	if name := elasticsearchDatePattern.ReplaceAllString(index, ""); name != strings.ToLower(name) {
+		return nil, fmt.Errorf("invalid Elasticsearch index (channel) '%s', must be lowercase", index)
+	}
+
+	e := &Elasticsearch{
+		URL:       strings.TrimSuffix(address, "/") + "/_bulk",
+		ProxyURL:  proxyURL,
+		TLSConfig: tlsConfig,
+		Index:     index,
+		Username:  username,
+		Password:  password,
+		batchKey:  "elasticsearch/" + providerUID,
+		batch:     newBatchOptions(batch),
+	}
+	if val, ok := secretData["apiKey"]; ok {
+		e.APIKey = strings.TrimSpace(string(val))
+	}
+
+	return e, nil
+}
+
+// Post adds the event to the batch of the provider, and returns once the batch is indexed with the Bulk API.
+func (e *Elasticsearch) Post(ctx context.Context, event eventv1.Event) error {
+	timestamp := event.Timestamp.Time
+	if timestamp.IsZero() {
+		timestamp = time.Now()
+	}
+	index := elasticsearchIndex(e.Index, timestamp)
+
+	action, err := json.Marshal(map[string]any{
+		"create": map[string]string{"_index": index},
+	})
+	if err != nil {
+		return fmt.Errorf("error json-marshaling bulk action: %w", err)
+	}
+	doc, err := json.Marshal(elasticsearchDocument{
+		Timestamp: timestamp.UTC(),
+		Event:     event,
+	})
+	if err != nil {
+		return fmt.Errorf("error json-marshaling event: %w", err)
+	}
+
+	item := make([]byte, 0, len(action)+len(doc)+2)
+	item = append(item, action...)
+	item = append(item, '\n')
+	item = append(item, doc...)
+	item = append(item, '\n')
+
+	if err := addToBatch(ctx, e.batchKey, e.batch, e.flush, item); err != nil {
+		return fmt.Errorf("error indexing events in Elasticsearch: %w", err)
+	}
+
+	// debug log
+	log.FromContext(ctx).V(1).Info("Event indexed in Elasticsearch", "index", index)
+
+	return nil
+}
+
+// flush indexes the batched events with a single Bulk API request.
+func (e *Elasticsearch) flush(ctx context.Context, items [][]byte) error {
+	opts := []postOption{
+		withProxy(e.ProxyURL),
+		withTLSConfig(e.TLSConfig),
+		withContentType("application/x-ndjson"),
+	}
+	switch {
+	case e.APIKey != "":
+		opts = append(opts, withRequestModifier(func(req *retryablehttp.Request) {
+			req.Header.Set("Authorization", "ApiKey "+e.APIKey)
+		}))
+	case e.Username != "":
+		opts = append(opts, withBasicAuth(e.Username, e.Password))
+	}
+
+	var resp elasticsearchBulkResponse
+	opts = append(opts, withResponseBody(&resp))
+	if err := postMessage(ctx, e.URL, bytes.Join(items, nil), opts...); err != nil {
+		return err
+	}
+
+	// The Bulk API reports the failures of the documents in the items of a successful response.
+	if !resp.Errors {
+		return nil
+	}
+	var failed int
+	var reason string
+	for _, item := range resp.Items {
+		for _, result := range item {
+			if result.Error == nil {
+				continue
+			}
+			failed++
+			if reason == "" {
+				reason = fmt.Sprintf("status %d: %s: %s", result.Status, result.Error.Type, result.Error.Reason)
+			}
+		}
+	}
+	return fmt.Errorf("%d of %d documents failed to be indexed, first failure with %s", failed, len(items), reason)
+}
+
+// elasticsearchIndex returns the index with the date patterns formatted with the UTC time.
+func elasticsearchIndex(pattern string, t time.Time) string {
+	return elasticsearchDatePattern.ReplaceAllStringFunc(pattern, func(m string) string {
+		layout := elasticsearchDatePattern.FindStringSubmatch(m)[1]
+		return t.UTC().Format(elasticsearchDateLayout.Replace(layout))
+	})
+}
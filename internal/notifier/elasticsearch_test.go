@@ -0,0 +1,185 @@
+/*
+Copyright 2026 The Flux authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notifier
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	eventv1 "github.com/fluxcd/pkg/apis/event/v1beta1"
+
+	apiv1beta3 "github.com/fluxcd/notification-controller/api/v1beta3"
+)
+
+func TestNewElasticsearch(t *testing.T) {
+	tests := []struct {
+		name          string
+		address       string
+		index         string
+		expectedErr   string
+		expectedIndex string
+	}{
+		{
+			name:        "invalid address",
+			address:     "elasticsearch",
+			expectedErr: "invalid Elasticsearch URL (address)",
+		},
+		{
+			name:        "index must be lowercase",
+			address:     "https://elasticsearch:9200",
+			index:       "Flux-%{+yyyy.MM.dd}",
+			expectedErr: "invalid Elasticsearch index (channel) 'Flux-%{+yyyy.MM.dd}', must be lowercase",
+		},
+		{
+			name:          "default index",
+			address:       "https://elasticsearch:9200/",
+			expectedIndex: "flux-events-%{+yyyy.MM.dd}",
+		},
+		{
+			name:          "custom index",
+			address:       "https://elasticsearch:9200",
+			index:         "logs-flux-%{+YYYY.MM}",
+			expectedIndex: "logs-flux-%{+YYYY.MM}",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			e, err := NewElasticsearch("uid", tt.address, "", nil, tt.index, "", "", nil, nil)
+			if tt.expectedErr != "" {
+				g.Expect(err).To(MatchError(ContainSubstring(tt.expectedErr)))
+				g.Expect(e).To(BeNil())
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(e.URL).To(Equal("https://elasticsearch:9200/_bulk"))
+			g.Expect(e.Index).To(Equal(tt.expectedIndex))
+		})
+	}
+}
+
+func TestElasticsearchIndex(t *testing.T) {
+	ts := time.Date(2026, 1, 2, 3, 4, 5, 0, time.FixedZone("CET", 3600))
+
+	for pattern, expected := range map[string]string{
+		"flux":                          "flux",
+		"flux-events-%{+yyyy.MM.dd}":    "flux-events-2026.01.02",
+		"flux-%{+YYYY.MM}":              "flux-2026.01",
+		"flux-%{+yy}-%{+dd}-%{+HH}":     "flux-26-02-02",
+		"%{+yyyy}/flux/%{+yyyy-MM-dd}x": "2026/flux/2026-01-02x",
+	} {
+		t.Run(pattern, func(t *testing.T) {
+			g := NewWithT(t)
+			g.Expect(elasticsearchIndex(pattern, ts)).To(Equal(expected))
+		})
+	}
+}
+
+func TestElasticsearch_Post(t *testing.T) {
+	event := eventv1.Event{
+		InvolvedObject: corev1.ObjectReference{
+			Kind:      "HelmRelease",
+			Namespace: "apps",
+			Name:      "podinfo",
+		},
+		Severity:  eventv1.EventSeverityInfo,
+		Reason:    "UpgradeSucceeded",
+		Message:   "Helm upgrade succeeded",
+		Timestamp: metav1.NewTime(time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)),
+	}
+
+	tests := []struct {
+		name         string
+		username     string
+		password     string
+		secretData   map[string][]byte
+		response     string
+		expectedAuth string
+		expectedErr  string
+	}{
+		{
+			name:         "API key",
+			secretData:   map[string][]byte{"apiKey": []byte("ZW5jb2RlZA==")},
+			response:     `{"errors":false,"items":[{"create":{"status":201}}]}`,
+			expectedAuth: "ApiKey ZW5jb2RlZA==",
+		},
+		{
+			name:         "basic auth",
+			username:     "flux",
+			password:     "pass",
+			response:     `{"errors":false,"items":[{"create":{"status":201}}]}`,
+			expectedAuth: "Basic " + basicAuth("flux", "pass"),
+		},
+		{
+			name:         "document failures are reported",
+			secretData:   map[string][]byte{"apiKey": []byte("ZW5jb2RlZA==")},
+			response:     `{"errors":true,"items":[{"create":{"status":400,"error":{"type":"mapper_parsing_exception","reason":"failed to parse field [metadata]"}}}]}`,
+			expectedAuth: "ApiKey ZW5jb2RlZA==",
+			expectedErr:  "error indexing events in Elasticsearch: 1 of 1 documents failed to be indexed, first failure with status 400: mapper_parsing_exception: failed to parse field [metadata]",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			var lines []map[string]any
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				g.Expect(r.URL.Path).To(Equal("/_bulk"))
+				g.Expect(r.Header.Get("Content-Type")).To(Equal("application/x-ndjson"))
+				g.Expect(r.Header.Get("Authorization")).To(Equal(tt.expectedAuth))
+				scanner := bufio.NewScanner(r.Body)
+				for scanner.Scan() {
+					var line map[string]any
+					g.Expect(json.Unmarshal(scanner.Bytes(), &line)).To(Succeed())
+					lines = append(lines, line)
+				}
+				w.Write([]byte(tt.response))
+			}))
+			defer ts.Close()
+
+			batch := &apiv1beta3.BatchOptions{FlushInterval: &metav1.Duration{Duration: 10 * time.Millisecond}}
+			e, err := NewElasticsearch(t.Name(), ts.URL, "", nil, "", tt.username, tt.password, tt.secretData, batch)
+			g.Expect(err).ToNot(HaveOccurred())
+
+			err = e.Post(context.TODO(), event)
+			if tt.expectedErr != "" {
+				g.Expect(err).To(MatchError(tt.expectedErr))
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(lines).To(HaveLen(2))
+			g.Expect(lines[0]).To(Equal(map[string]any{
+				"create": map[string]any{"_index": "flux-events-2026.10.18"},
+			}))
+			g.Expect(lines[1]).To(HaveKeyWithValue("@timestamp", "2026-10-18T12:00:00Z"))
+			g.Expect(lines[1]).To(HaveKeyWithValue("reason", "UpgradeSucceeded"))
+			g.Expect(lines[1]).To(HaveKeyWithValue("involvedObject", HaveKeyWithValue("name", "podinfo")))
+		})
+	}
+}
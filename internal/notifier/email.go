@@ -0,0 +1,402 @@
+/*
+Copyright 2026 The Flux authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notifier
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"html/template"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"net/textproto"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	eventv1 "github.com/fluxcd/pkg/apis/event/v1beta1"
+
+	apiv1beta3 "github.com/fluxcd/notification-controller/api/v1beta3"
+)
+
+const (
+	emailAuthPlain = "PLAIN"
+	emailAuthLogin = "LOGIN"
+
+	// emailCcPrefix is the prefix of the Cc recipients in the channel.
+	emailCcPrefix = "cc:"
+)
+
+// Email holds the SMTP server address and the message envelope.
+type Email struct {
+	host        string
+	hostname    string
+	implicitTLS bool
+	from        string
+	to          []string
+	cc          []string
+	username    string
+	password    string
+	authMethod  string
+	insecure    bool
+	tlsConfig   *tls.Config
+}
+
+// NewEmail validates the SMTP server address and the recipients and returns an Email object.
+//
+// The address must be in the format 'smtp://host[:port]' for STARTTLS (port 587 by default),
+// or 'smtps://host[:port]' for implicit TLS (port 465 by default). The recipients are a comma
+// separated list of addresses, the addresses prefixed with 'cc:' are sent as Cc.
+// The sender address is read from the options, defaulting to the username, and the
+// authentication mechanism is PLAIN unless set otherwise by the options. Sending over
+// 'smtp://' fails if the server doesn't offer STARTTLS, unless the options allow it.
+func NewEmail(address, recipients, username, password string, tlsConfig *tls.Config,
+	opts *apiv1beta3.EmailOptions) (*Email, error) {
+	u, err := url.Parse(address)
+	if err != nil {
+		return nil, fmt.Errorf("invalid SMTP server address %s: '%w'", address, err)
+	}
+
+	e := &Email{
+		hostname:   u.Hostname(),
+		username:   username,
+		password:   password,
+		authMethod: emailAuthPlain,
+	}
+
+	port := u.Port()
+	switch u.Scheme {
+	case "smtp":
+		if port == "" {
+			port = "587"
+		}
+	case "smtps":
+		e.implicitTLS = true
+		if port == "" {
+			port = "465"
+		}
+	default:
+		return nil, fmt.Errorf("invalid SMTP server address %s: scheme must be 'smtp' or 'smtps'", address)
+	}
+	if e.hostname == "" {
+		return nil, fmt.Errorf("invalid SMTP server address %s: host cannot be empty", address)
+	}
+	e.host = net.JoinHostPort(e.hostname, port)
+
+	from := username
+	if opts != nil && opts.From != "" {
+		from = strings.TrimSpace(opts.From)
+	}
+	fromAddr, err := mail.ParseAddress(from)
+	if err != nil {
+		return nil, fmt.Errorf("invalid sender address '%s': %w", from, err)
+	}
+	e.from = fromAddr.Address
+
+	for _, r := range strings.Split(recipients, ",") {
+		r = strings.TrimSpace(r)
+		if r == "" {
+			continue
+		}
+		cc := strings.HasPrefix(strings.ToLower(r), emailCcPrefix)
+		if cc {
+			r = strings.TrimSpace(r[len(emailCcPrefix):])
+		}
+		addr, err := mail.ParseAddress(r)
+		if err != nil {
+			return nil, fmt.Errorf("invalid recipient address '%s': %w", r, err)
+		}
+		if cc {
+			e.cc = append(e.cc, addr.Address)
+		} else {
+			e.to = append(e.to, addr.Address)
+		}
+	}
+	if len(e.to) == 0 {
+		return nil, errors.New("email recipients (channel) cannot be empty")
+	}
+
+	if opts != nil {
+		if opts.AuthMethod != "" {
+			e.authMethod = strings.ToUpper(strings.TrimSpace(opts.AuthMethod))
+		}
+		e.insecure = opts.Insecure
+	}
+	if e.authMethod != emailAuthPlain && e.authMethod != emailAuthLogin {
+		return nil, fmt.Errorf("unsupported SMTP authentication method '%s', must be one of: %s, %s",
+			e.authMethod, emailAuthPlain, emailAuthLogin)
+	}
+
+	if tlsConfig != nil {
+		e.tlsConfig = tlsConfig.Clone()
+	} else {
+		e.tlsConfig = &tls.Config{}
+	}
+	if e.tlsConfig.ServerName == "" {
+		e.tlsConfig.ServerName = e.hostname
+	}
+
+	return e, nil
+}
+
+// Post sends the event by email.
+func (e *Email) Post(ctx context.Context, event eventv1.Event) error {
+	msg, err := e.buildMessage(&event, GetLinks(ctx))
+	if err != nil {
+		return fmt.Errorf("failed to build email message: %w", err)
+	}
+
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "tcp", e.host)
+	if err != nil {
+		return fmt.Errorf("failed to connect to SMTP server %s: %w", e.host, err)
+	}
+	defer conn.Close()
+	if deadline, ok := ctx.Deadline(); ok {
+		if err := conn.SetDeadline(deadline); err != nil {
+			return err
+		}
+	}
+
+	if e.implicitTLS {
+		tlsConn := tls.Client(conn, e.tlsConfig)
+		if err := tlsConn.HandshakeContext(ctx); err != nil {
+			return fmt.Errorf("TLS handshake with SMTP server %s failed: %w", e.host, err)
+		}
+		conn = tlsConn
+	}
+
+	c, err := smtp.NewClient(conn, e.hostname)
+	if err != nil {
+		return fmt.Errorf("failed to create SMTP client: %w", err)
+	}
+	defer c.Close()
+
+	if !e.implicitTLS {
+		if ok, _ := c.Extension("STARTTLS"); ok {
+			if err := c.StartTLS(e.tlsConfig); err != nil {
+				return fmt.Errorf("STARTTLS failed: %w", err)
+			}
+		} else if !e.insecure {
+			return fmt.Errorf("SMTP server %s doesn't support STARTTLS, refusing to send the email in plaintext", e.host)
+		}
+	}
+
+	if e.username != "" {
+		if ok, _ := c.Extension("AUTH"); !ok {
+			return errors.New("SMTP server doesn't support authentication")
+		}
+		var auth smtp.Auth
+		switch e.authMethod {
+		case emailAuthLogin:
+			auth = &loginAuth{username: e.username, password: e.password, host: e.hostname}
+		default:
+			auth = smtp.PlainAuth("", e.username, e.password, e.hostname)
+		}
+		if err := c.Auth(auth); err != nil {
+			return fmt.Errorf("SMTP authentication failed: %w", err)
+		}
+	}
+
+	if err := c.Mail(e.from); err != nil {
+		return fmt.Errorf("SMTP MAIL command failed: %w", err)
+	}
+	for _, rcpt := range slices.Concat(e.to, e.cc) {
+		if err := c.Rcpt(rcpt); err != nil {
+			return fmt.Errorf("SMTP RCPT command failed for '%s': %w", rcpt, err)
+		}
+	}
+	w, err := c.Data()
+	if err != nil {
+		return fmt.Errorf("SMTP DATA command failed: %w", err)
+	}
+	if _, err := w.Write(msg); err != nil {
+		return fmt.Errorf("failed to write email message: %w", err)
+	}
+	if err := w.Close(); err != nil {
+		return fmt.Errorf("failed to send email message: %w", err)
+	}
+
+	return c.Quit()
+}
+
+// emailMetadata is a metadata entry of the email body.
+type emailMetadata struct {
+	Key   string
+	Value string
+}
+
+var emailHTMLTemplate = template.Must(template.New("email").Parse(`<html>
+<body>
+<h3 style="color: {{ .Color }}">{{ .Object }}</h3>
+<p style="white-space: pre-wrap">{{ .Message }}</p>
+{{- if .Metadata }}
+<table>
+{{- range .Metadata }}
+<tr><th align="left">{{ .Key }}</th><td>{{ .Value }}</td></tr>
+{{- end }}
+</table>
+{{- end }}
+{{- if .Links }}
+<p>
+{{- range .Links }}
+<a href="{{ .URL }}">{{ .Name }}</a>
+{{- end }}
+</p>
+{{- end }}
+</body>
+</html>
+`))
+
+// buildMessage returns the MIME message of the event, with
+// a plain-text and an HTML alternative of the body.
+func (e *Email) buildMessage(event *eventv1.Event, links []Link) ([]byte, error) {
+	objName := fmt.Sprintf("%s/%s.%s", strings.ToLower(event.InvolvedObject.Kind),
+		event.InvolvedObject.Name, event.InvolvedObject.Namespace)
+
+	keys := make([]string, 0, len(event.Metadata))
+	for k := range event.Metadata {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	metadata := make([]emailMetadata, 0, len(keys))
+	for _, k := range keys {
+		metadata = append(metadata, emailMetadata{Key: k, Value: event.Metadata[k]})
+	}
+
+	var text strings.Builder
+	fmt.Fprintf(&text, "%s\n\n%s\n", objName, event.Message)
+	if len(metadata) > 0 {
+		text.WriteString("\nMetadata:\n")
+		for _, m := range metadata {
+			fmt.Fprintf(&text, "- %s: %s\n", m.Key, m.Value)
+		}
+	}
+	if len(links) > 0 {
+		text.WriteString("\nLinks:\n")
+		for _, l := range links {
+			fmt.Fprintf(&text, "- %s: %s\n", l.Name, l.URL)
+		}
+	}
+
+	color := "#2eb886"
+	if event.Severity == eventv1.EventSeverityError {
+		color = "#d00000"
+	}
+	var html bytes.Buffer
+	if err := emailHTMLTemplate.Execute(&html, map[string]any{
+		"Color":    color,
+		"Object":   objName,
+		"Message":  event.Message,
+		"Metadata": metadata,
+		"Links":    links,
+	}); err != nil {
+		return nil, err
+	}
+
+	var body bytes.Buffer
+	mw := multipart.NewWriter(&body)
+	for _, part := range []struct {
+		contentType string
+		content     []byte
+	}{
+		{"text/plain; charset=utf-8", []byte(text.String())},
+		{"text/html; charset=utf-8", html.Bytes()},
+	} {
+		pw, err := mw.CreatePart(textproto.MIMEHeader{
+			"Content-Type":              {part.contentType},
+			"Content-Transfer-Encoding": {"quoted-printable"},
+		})
+		if err != nil {
+			return nil, err
+		}
+		qw := quotedprintable.NewWriter(pw)
+		if _, err := qw.Write(part.content); err != nil {
+			return nil, err
+		}
+		if err := qw.Close(); err != nil {
+			return nil, err
+		}
+	}
+	if err := mw.Close(); err != nil {
+		return nil, err
+	}
+
+	subject := fmt.Sprintf("[%s] %s", event.Severity, objName)
+	if event.Reason != "" {
+		subject = fmt.Sprintf("%s: %s", subject, event.Reason)
+	}
+
+	var msg bytes.Buffer
+	fmt.Fprintf(&msg, "From: %s\r\n", e.from)
+	fmt.Fprintf(&msg, "To: %s\r\n", strings.Join(e.to, ", "))
+	if len(e.cc) > 0 {
+		fmt.Fprintf(&msg, "Cc: %s\r\n", strings.Join(e.cc, ", "))
+	}
+	fmt.Fprintf(&msg, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", subject))
+	fmt.Fprintf(&msg, "Date: %s\r\n", time.Now().Format(time.RFC1123Z))
+	msg.WriteString("MIME-Version: 1.0\r\n")
+	fmt.Fprintf(&msg, "Content-Type: multipart/alternative; boundary=%s\r\n\r\n", mw.Boundary())
+	msg.Write(body.Bytes())
+
+	return msg.Bytes(), nil
+}
+
+// loginAuth implements the LOGIN authentication mechanism, which
+// is not part of net/smtp but still required by some SMTP servers.
+type loginAuth struct {
+	username string
+	password string
+	host     string
+}
+
+func (a *loginAuth) Start(server *smtp.ServerInfo) (string, []byte, error) {
+	// Like smtp.PlainAuth, only send the credentials over
+	// encrypted connections, or to localhost.
+	if !server.TLS && !isLocalhost(server.Name) {
+		return "", nil, errors.New("unencrypted connection")
+	}
+	if server.Name != a.host {
+		return "", nil, errors.New("wrong host name")
+	}
+	return emailAuthLogin, nil, nil
+}
+
+func (a *loginAuth) Next(fromServer []byte, more bool) ([]byte, error) {
+	if !more {
+		return nil, nil
+	}
+	switch strings.ToLower(strings.TrimSpace(string(fromServer))) {
+	case "username:":
+		return []byte(a.username), nil
+	case "password:":
+		return []byte(a.password), nil
+	default:
+		return nil, fmt.Errorf("unexpected server challenge '%s'", fromServer)
+	}
+}
+
+func isLocalhost(name string) bool {
+	return name == "localhost" || name == "127.0.0.1" || name == "::1"
+}
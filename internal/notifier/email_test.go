@@ -0,0 +1,338 @@
+/*
+Copyright 2026 The Flux authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notifier
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/mail"
+	"net/textproto"
+	"strings"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	apiv1beta3 "github.com/fluxcd/notification-controller/api/v1beta3"
+)
+
+// smtpStub is a minimal SMTP server recording the received message.
+type smtpStub struct {
+	addr      string
+	tlsConfig *tls.Config
+	implicit  bool
+	startTLS  bool
+	results   chan smtpStubResult
+}
+
+type smtpStubResult struct {
+	auth  []string
+	from  string
+	rcpts []string
+	data  string
+	tls   bool
+}
+
+func newSMTPStub(t *testing.T, implicitTLS, startTLS bool) *smtpStub {
+	t.Helper()
+
+	// Reuse the certificate of a TLS test server, valid for 127.0.0.1.
+	ts := httptest.NewTLSServer(http.NotFoundHandler())
+	t.Cleanup(ts.Close)
+	tlsConfig := &tls.Config{Certificates: ts.TLS.Certificates}
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if implicitTLS {
+		l = tls.NewListener(l, tlsConfig)
+	}
+	t.Cleanup(func() { l.Close() })
+
+	s := &smtpStub{
+		addr:      l.Addr().String(),
+		tlsConfig: tlsConfig,
+		implicit:  implicitTLS,
+		startTLS:  startTLS,
+		results:   make(chan smtpStubResult, 1),
+	}
+	go func() {
+		conn, err := l.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		s.serve(conn)
+	}()
+	return s
+}
+
+func (s *smtpStub) serve(conn net.Conn) {
+	result := smtpStubResult{tls: s.implicit}
+	tp := textproto.NewConn(conn)
+	tp.PrintfLine("220 localhost ESMTP stub")
+	for {
+		line, err := tp.ReadLine()
+		if err != nil {
+			return
+		}
+		cmd, arg, _ := strings.Cut(line, " ")
+		switch strings.ToUpper(cmd) {
+		case "EHLO":
+			tp.PrintfLine("250-localhost")
+			if !result.tls && s.startTLS {
+				tp.PrintfLine("250-STARTTLS")
+			}
+			tp.PrintfLine("250 AUTH PLAIN LOGIN")
+		case "STARTTLS":
+			tp.PrintfLine("220 ready")
+			tlsConn := tls.Server(conn, s.tlsConfig)
+			if err := tlsConn.Handshake(); err != nil {
+				return
+			}
+			conn = tlsConn
+			tp = textproto.NewConn(conn)
+			result.tls = true
+		case "AUTH":
+			mech, initial, _ := strings.Cut(arg, " ")
+			switch mech {
+			case "PLAIN":
+				b, _ := base64.StdEncoding.DecodeString(initial)
+				result.auth = append([]string{mech}, strings.Split(string(b), "\x00")...)
+			case "LOGIN":
+				result.auth = []string{mech}
+				for _, challenge := range []string{"Username:", "Password:"} {
+					tp.PrintfLine("334 %s", base64.StdEncoding.EncodeToString([]byte(challenge)))
+					resp, _ := tp.ReadLine()
+					b, _ := base64.StdEncoding.DecodeString(resp)
+					result.auth = append(result.auth, string(b))
+				}
+			}
+			tp.PrintfLine("235 authenticated")
+		case "MAIL":
+			result.from = strings.Trim(strings.TrimPrefix(arg, "FROM:"), "<>")
+			tp.PrintfLine("250 ok")
+		case "RCPT":
+			result.rcpts = append(result.rcpts, strings.Trim(strings.TrimPrefix(arg, "TO:"), "<>"))
+			tp.PrintfLine("250 ok")
+		case "DATA":
+			tp.PrintfLine("354 send data")
+			b, _ := io.ReadAll(tp.DotReader())
+			result.data = string(b)
+			tp.PrintfLine("250 queued")
+		case "QUIT":
+			tp.PrintfLine("221 bye")
+			s.results <- result
+			return
+		default:
+			tp.PrintfLine("502 not implemented")
+		}
+	}
+}
+
+func (s *smtpStub) rootCAs() *x509.CertPool {
+	pool := x509.NewCertPool()
+	cert, _ := x509.ParseCertificate(s.tlsConfig.Certificates[0].Certificate[0])
+	pool.AddCert(cert)
+	return pool
+}
+
+func TestNewEmail(t *testing.T) {
+	tests := []struct {
+		name       string
+		address    string
+		recipients string
+		username   string
+		opts       *apiv1beta3.EmailOptions
+		wantHost   string
+		wantTo     []string
+		wantCc     []string
+		wantErr    string
+	}{
+		{
+			name:       "STARTTLS default port",
+			address:    "smtp://smtp.example.com",
+			recipients: "ops@example.com, cc:audit@example.com",
+			username:   "flux@example.com",
+			wantHost:   "smtp.example.com:587",
+			wantTo:     []string{"ops@example.com"},
+			wantCc:     []string{"audit@example.com"},
+		},
+		{
+			name:       "implicit TLS default port",
+			address:    "smtps://smtp.example.com",
+			recipients: "ops@example.com",
+			opts:       &apiv1beta3.EmailOptions{From: "Flux <flux@example.com>"},
+			wantHost:   "smtp.example.com:465",
+			wantTo:     []string{"ops@example.com"},
+		},
+		{
+			name:       "invalid scheme",
+			address:    "https://smtp.example.com",
+			recipients: "ops@example.com",
+			username:   "flux@example.com",
+			wantErr:    "scheme must be 'smtp' or 'smtps'",
+		},
+		{
+			name:       "missing sender",
+			address:    "smtp://smtp.example.com",
+			recipients: "ops@example.com",
+			wantErr:    "invalid sender address",
+		},
+		{
+			name:       "missing recipients",
+			address:    "smtp://smtp.example.com",
+			recipients: "cc:audit@example.com",
+			username:   "flux@example.com",
+			wantErr:    "recipients (channel) cannot be empty",
+		},
+		{
+			name:       "unsupported auth method",
+			address:    "smtp://smtp.example.com",
+			recipients: "ops@example.com",
+			username:   "flux@example.com",
+			opts:       &apiv1beta3.EmailOptions{AuthMethod: "CRAM-MD5"},
+			wantErr:    "unsupported SMTP authentication method 'CRAM-MD5'",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			e, err := NewEmail(tt.address, tt.recipients, tt.username, "", nil, tt.opts)
+			if tt.wantErr != "" {
+				g.Expect(err).To(MatchError(ContainSubstring(tt.wantErr)))
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(e.host).To(Equal(tt.wantHost))
+			g.Expect(e.to).To(Equal(tt.wantTo))
+			g.Expect(e.cc).To(Equal(tt.wantCc))
+			g.Expect(e.tlsConfig.ServerName).To(Equal("smtp.example.com"))
+		})
+	}
+}
+
+func TestEmail_Post(t *testing.T) {
+	tests := []struct {
+		name        string
+		implicitTLS bool
+		noStartTLS  bool
+		insecure    bool
+		authMethod  string
+		wantTLS     bool
+		wantAuth    []string
+		wantErr     string
+	}{
+		{
+			name:       "STARTTLS with PLAIN auth",
+			authMethod: "PLAIN",
+			wantTLS:    true,
+			wantAuth:   []string{"PLAIN", "", "flux", "secret"},
+		},
+		{
+			name:        "implicit TLS with LOGIN auth",
+			implicitTLS: true,
+			authMethod:  "login",
+			wantTLS:     true,
+			wantAuth:    []string{"LOGIN", "flux", "secret"},
+		},
+		{
+			name:       "STARTTLS not offered",
+			noStartTLS: true,
+			wantErr:    "doesn't support STARTTLS",
+		},
+		{
+			name:       "STARTTLS not offered with insecure",
+			noStartTLS: true,
+			insecure:   true,
+			wantAuth:   []string{"PLAIN", "", "flux", "secret"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			stub := newSMTPStub(t, tt.implicitTLS, !tt.noStartTLS)
+
+			scheme := "smtp"
+			if tt.implicitTLS {
+				scheme = "smtps"
+			}
+			email, err := NewEmail(scheme+"://"+stub.addr, "ops@example.com,cc:audit@example.com",
+				"flux", "secret", &tls.Config{RootCAs: stub.rootCAs()}, &apiv1beta3.EmailOptions{
+					From:       "flux@example.com",
+					AuthMethod: tt.authMethod,
+					Insecure:   tt.insecure,
+				})
+			g.Expect(err).ToNot(HaveOccurred())
+
+			event := testEvent()
+			event.Severity = "error"
+			ctx := WithLinks(context.TODO(), []Link{{Name: "Dashboard", URL: "https://dashboard.example.com"}})
+			err = email.Post(ctx, event)
+			if tt.wantErr != "" {
+				g.Expect(err).To(MatchError(ContainSubstring(tt.wantErr)))
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+
+			var result smtpStubResult
+			g.Eventually(stub.results).Should(Receive(&result))
+			g.Expect(result.tls).To(Equal(tt.wantTLS))
+			g.Expect(result.auth).To(Equal(tt.wantAuth))
+			g.Expect(result.from).To(Equal("flux@example.com"))
+			g.Expect(result.rcpts).To(Equal([]string{"ops@example.com", "audit@example.com"}))
+
+			msg, err := mail.ReadMessage(strings.NewReader(result.data))
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(msg.Header.Get("To")).To(Equal("ops@example.com"))
+			g.Expect(msg.Header.Get("Cc")).To(Equal("audit@example.com"))
+			g.Expect(msg.Header.Get("Subject")).To(Equal("[error] gitrepository/webapp.gitops-system: reason"))
+
+			mediaType, params, err := mime.ParseMediaType(msg.Header.Get("Content-Type"))
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(mediaType).To(Equal("multipart/alternative"))
+
+			parts := map[string]string{}
+			mr := multipart.NewReader(msg.Body, params["boundary"])
+			for {
+				p, err := mr.NextPart()
+				if err == io.EOF {
+					break
+				}
+				g.Expect(err).ToNot(HaveOccurred())
+				b, err := io.ReadAll(p)
+				g.Expect(err).ToNot(HaveOccurred())
+				parts[strings.Split(p.Header.Get("Content-Type"), ";")[0]] = string(b)
+			}
+			g.Expect(parts["text/plain"]).To(Equal("gitrepository/webapp.gitops-system\n\nmessage\n\n" +
+				"Metadata:\n- test: metadata\n\nLinks:\n- Dashboard: https://dashboard.example.com\n"))
+			g.Expect(parts["text/html"]).To(ContainSubstring(`<h3 style="color: #d00000">gitrepository/webapp.gitops-system</h3>`))
+			g.Expect(parts["text/html"]).To(ContainSubstring(`<tr><th align="left">test</th><td>metadata</td></tr>`))
+			g.Expect(parts["text/html"]).To(ContainSubstring(`<a href="https://dashboard.example.com">Dashboard</a>`))
+		})
+	}
+}
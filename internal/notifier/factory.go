@@ -56,16 +56,42 @@ var (
 		apiv1.GitHubProvider:                    gitHubNotifierFunc,
 		apiv1.GitHubDispatchProvider:            gitHubDispatchNotifierFunc,
 		apiv1.GitHubPullRequestCommentProvider:  gitHubPullRequestCommentNotifierFunc,
+		apiv1.GitHubIssueProvider:               gitHubIssueNotifierFunc,
 		apiv1.GitLabProvider:                    gitLabNotifierFunc,
 		apiv1.GitLabMergeRequestCommentProvider: gitLabMergeRequestCommentNotifierFunc,
+		apiv1.GitLabIssueProvider:               gitLabIssueNotifierFunc,
 		apiv1.GiteaProvider:                     giteaNotifierFunc,
 		apiv1.GiteaPullRequestCommentProvider:   giteaPullRequestCommentNotifierFunc,
+		apiv1.GiteaIssueProvider:                giteaIssueNotifierFunc,
 		apiv1.BitbucketServerProvider:           bitbucketServerNotifierFunc,
 		apiv1.BitbucketProvider:                 bitbucketNotifierFunc,
 		apiv1.AzureDevOpsProvider:               azureDevOpsNotifierFunc,
 		apiv1.ZulipProvider:                     zulipNotifierFunc,
 		apiv1.OTELProvider:                      otelNotifierFunc,
 		apiv1.ZoomProvider:                      zoomNotifierFunc,
+		apiv1.EmailProvider:                     emailNotifierFunc,
+		apiv1.KafkaProvider:                     kafkaNotifierFunc,
+		apiv1.MQTTProvider:                      mqttNotifierFunc,
+		apiv1.AMQPProvider:                      amqpNotifierFunc,
+		apiv1.AWSSNSProvider:                    awsSNSNotifierFunc,
+		apiv1.AWSSQSProvider:                    awsSQSNotifierFunc,
+		apiv1.JiraProvider:                      jiraNotifierFunc,
+		apiv1.ServiceNowProvider:                serviceNowNotifierFunc,
+		apiv1.SplunkHECProvider:                 splunkHECNotifierFunc,
+		apiv1.ElasticsearchProvider:             elasticsearchNotifierFunc,
+		apiv1.LokiProvider:                      lokiNotifierFunc,
+		apiv1.CloudEventsProvider:               cloudEventsNotifierFunc,
+		apiv1.MattermostProvider:                mattermostNotifierFunc,
+		apiv1.NtfyProvider:                      ntfyNotifierFunc,
+		apiv1.GotifyProvider:                    gotifyNotifierFunc,
+		apiv1.PushoverProvider:                  pushoverNotifierFunc,
+		apiv1.SplunkOnCallProvider:              splunkOnCallNotifierFunc,
+		apiv1.IncidentIOProvider:                incidentIONotifierFunc,
+		apiv1.GrafanaOnCallProvider:             grafanaOnCallNotifierFunc,
+		apiv1.DingTalkProvider:                  dingTalkNotifierFunc,
+		apiv1.WeComProvider:                     weComNotifierFunc,
+		apiv1.SyslogProvider:                    syslogNotifierFunc,
+		apiv1.RedisProvider:                     redisNotifierFunc,
 	}
 )
 
@@ -93,6 +119,7 @@ type notifierOptions struct {
 	ProviderName       string
 	ProviderNamespace  string
 	SecretData         map[string][]byte
+	ProviderSpec       apiv1.ProviderSpec
 	ServiceAccountName string
 	TokenCache         *cache.TokenCache
 	TokenClient        client.Client
@@ -196,6 +223,14 @@ func WithSecretData(data map[string][]byte) Option {
 	}
 }
 
+// WithProviderSpec sets the Provider spec, holding the settings
+// specific to the provider type, for the notifier.
+func WithProviderSpec(spec apiv1.ProviderSpec) Option {
+	return func(o *notifierOptions) {
+		o.ProviderSpec = spec
+	}
+}
+
 // WithTokenCache sets the token cache for the notifier.
 func WithTokenCache(cache *cache.TokenCache) Option {
 	return func(o *notifierOptions) {
@@ -257,7 +292,7 @@ func genericHMACNotifierFunc(opts notifierOptions) (Interface, error) {
 }
 
 func slackNotifierFunc(opts notifierOptions) (Interface, error) {
-	return NewSlack(opts.URL, opts.ProxyURL, opts.Token, opts.TLSConfig, opts.Username, opts.Channel)
+	return NewSlack(opts.URL, opts.ProxyURL, opts.Token, opts.TLSConfig, opts.Username, opts.Channel, opts.ProviderUID, opts.ProviderSpec.Slack)
 }
 
 func discordNotifierFunc(opts notifierOptions) (Interface, error) {
@@ -269,7 +304,7 @@ func rocketNotifierFunc(opts notifierOptions) (Interface, error) {
 }
 
 func msteamsNotifierFunc(opts notifierOptions) (Interface, error) {
-	return NewMSTeams(opts.URL, opts.ProxyURL, opts.TLSConfig)
+	return NewMSTeams(opts.URL, opts.ProxyURL, opts.TLSConfig, opts.ProviderSpec.MSTeams)
 }
 
 func googleChatNotifierFunc(opts notifierOptions) (Interface, error) {
@@ -297,7 +332,7 @@ func telegramNotifierFunc(opts notifierOptions) (Interface, error) {
 }
 
 func larkNotifierFunc(opts notifierOptions) (Interface, error) {
-	return NewLark(opts.URL)
+	return NewLark(opts.URL, opts.SecretData)
 }
 
 func matrixNotifierFunc(opts notifierOptions) (Interface, error) {
@@ -309,7 +344,7 @@ func opsgenieNotifierFunc(opts notifierOptions) (Interface, error) {
 }
 
 func alertmanagerNotifierFunc(opts notifierOptions) (Interface, error) {
-	return NewAlertmanager(opts.URL, opts.ProxyURL, opts.TLSConfig, opts.Token, opts.Username, opts.Password)
+	return NewAlertmanager(opts.URL, opts.ProxyURL, opts.TLSConfig, opts.Token, opts.Username, opts.Password, opts.ProviderSpec.Alertmanager)
 }
 
 func grafanaNotifierFunc(opts notifierOptions) (Interface, error) {
@@ -340,6 +375,10 @@ func gitHubPullRequestCommentNotifierFunc(opts notifierOptions) (Interface, erro
 	return NewGitHubPullRequestComment(opts.Context, opts.ProviderUID, opts.GitHubClientOptions()...)
 }
 
+func gitHubIssueNotifierFunc(opts notifierOptions) (Interface, error) {
+	return NewGitHubIssue(opts.Context, opts.ProviderUID, opts.GitHubClientOptions()...)
+}
+
 func gitLabNotifierFunc(opts notifierOptions) (Interface, error) {
 	if opts.Token == "" && opts.Password != "" {
 		opts.Token = opts.Password
@@ -354,6 +393,13 @@ func gitLabMergeRequestCommentNotifierFunc(opts notifierOptions) (Interface, err
 	return NewGitLabMergeRequestComment(opts.ProviderUID, opts.URL, opts.Token, opts.TLSConfig)
 }
 
+func gitLabIssueNotifierFunc(opts notifierOptions) (Interface, error) {
+	if opts.Token == "" && opts.Password != "" {
+		opts.Token = opts.Password
+	}
+	return NewGitLabIssue(opts.ProviderUID, opts.URL, opts.Token, opts.TLSConfig)
+}
+
 func giteaNotifierFunc(opts notifierOptions) (Interface, error) {
 	return NewGitea(opts.CommitStatus, opts.GiteaClientOptions()...)
 }
@@ -362,6 +408,10 @@ func giteaPullRequestCommentNotifierFunc(opts notifierOptions) (Interface, error
 	return NewGiteaPullRequestComment(opts.ProviderUID, opts.GiteaClientOptions()...)
 }
 
+func giteaIssueNotifierFunc(opts notifierOptions) (Interface, error) {
+	return NewGiteaIssue(opts.ProviderUID, opts.GiteaClientOptions()...)
+}
+
 func bitbucketServerNotifierFunc(opts notifierOptions) (Interface, error) {
 	return NewBitbucketServer(opts.CommitStatus, opts.URL, opts.Token, opts.TLSConfig, opts.Username, opts.Password)
 }
@@ -390,3 +440,100 @@ func otelNotifierFunc(opts notifierOptions) (Interface, error) {
 func zoomNotifierFunc(opts notifierOptions) (Interface, error) {
 	return NewZoom(opts.URL, opts.ProxyURL, opts.TLSConfig, opts.Token)
 }
+
+func emailNotifierFunc(opts notifierOptions) (Interface, error) {
+	return NewEmail(opts.URL, opts.Channel, opts.Username, opts.Password, opts.TLSConfig, opts.ProviderSpec.Email)
+}
+
+func kafkaNotifierFunc(opts notifierOptions) (Interface, error) {
+	return NewKafka(opts.URL, opts.Channel, opts.Username, opts.Password, opts.TLSConfig, opts.ProviderSpec.Kafka)
+}
+
+func mqttNotifierFunc(opts notifierOptions) (Interface, error) {
+	return NewMQTT(opts.URL, opts.Channel, opts.Username, opts.Password, opts.TLSConfig, opts.ProviderSpec.MQTT)
+}
+
+func amqpNotifierFunc(opts notifierOptions) (Interface, error) {
+	return NewAMQP(opts.URL, opts.Channel, opts.Username, opts.Password, opts.TLSConfig, opts.ProviderSpec.AMQP)
+}
+
+func awsSNSNotifierFunc(opts notifierOptions) (Interface, error) {
+	return NewAWSSNS(&opts)
+}
+
+func awsSQSNotifierFunc(opts notifierOptions) (Interface, error) {
+	return NewAWSSQS(&opts)
+}
+
+func jiraNotifierFunc(opts notifierOptions) (Interface, error) {
+	return NewJira(opts.URL, opts.ProxyURL, opts.TLSConfig, opts.Channel, opts.Username, opts.Password, opts.Token, opts.ProviderSpec.Jira)
+}
+
+func serviceNowNotifierFunc(opts notifierOptions) (Interface, error) {
+	return NewServiceNow(opts.URL, opts.ProxyURL, opts.TLSConfig, opts.Channel, opts.Username, opts.Password, opts.SecretData,
+		opts.ProviderSpec.ServiceNow, opts.ProviderName, opts.ProviderNamespace, opts.TokenCache)
+}
+
+func splunkHECNotifierFunc(opts notifierOptions) (Interface, error) {
+	return NewSplunkHEC(opts.ProviderUID, opts.URL, opts.ProxyURL, opts.TLSConfig, opts.Channel, opts.Token,
+		opts.ProviderSpec.Batch, opts.ProviderSpec.SplunkHEC)
+}
+
+func elasticsearchNotifierFunc(opts notifierOptions) (Interface, error) {
+	return NewElasticsearch(opts.ProviderUID, opts.URL, opts.ProxyURL, opts.TLSConfig, opts.Channel, opts.Username, opts.Password,
+		opts.SecretData, opts.ProviderSpec.Batch)
+}
+
+func lokiNotifierFunc(opts notifierOptions) (Interface, error) {
+	return NewLoki(opts.ProviderUID, opts.URL, opts.ProxyURL, opts.TLSConfig, opts.Channel, opts.Username, opts.Password, opts.Token,
+		opts.ProviderSpec.Batch, opts.ProviderSpec.Loki)
+}
+
+func cloudEventsNotifierFunc(opts notifierOptions) (Interface, error) {
+	return NewCloudEvents(opts.URL, opts.ProxyURL, opts.TLSConfig, opts.Channel, opts.Headers,
+		opts.Username, opts.Password, opts.Token, opts.ProviderSpec.Kafka, opts.ProviderSpec.CloudEvents)
+}
+
+func mattermostNotifierFunc(opts notifierOptions) (Interface, error) {
+	return NewMattermost(opts.ProviderUID, opts.URL, opts.ProxyURL, opts.TLSConfig, opts.Token, opts.Username, opts.Channel)
+}
+
+func ntfyNotifierFunc(opts notifierOptions) (Interface, error) {
+	return NewNtfy(opts.URL, opts.ProxyURL, opts.TLSConfig, opts.Channel, opts.Username, opts.Password, opts.Token)
+}
+
+func gotifyNotifierFunc(opts notifierOptions) (Interface, error) {
+	return NewGotify(opts.URL, opts.ProxyURL, opts.TLSConfig, opts.Token)
+}
+
+func pushoverNotifierFunc(opts notifierOptions) (Interface, error) {
+	return NewPushover(opts.URL, opts.ProxyURL, opts.TLSConfig, opts.Channel, opts.Token)
+}
+
+func splunkOnCallNotifierFunc(opts notifierOptions) (Interface, error) {
+	return NewSplunkOnCall(opts.URL, opts.ProxyURL, opts.TLSConfig, opts.Channel)
+}
+
+func incidentIONotifierFunc(opts notifierOptions) (Interface, error) {
+	return NewIncidentIO(opts.URL, opts.ProxyURL, opts.TLSConfig, opts.Token)
+}
+
+func grafanaOnCallNotifierFunc(opts notifierOptions) (Interface, error) {
+	return NewGrafanaOnCall(opts.URL, opts.ProxyURL, opts.TLSConfig)
+}
+
+func dingTalkNotifierFunc(opts notifierOptions) (Interface, error) {
+	return NewDingTalk(opts.URL, opts.ProxyURL, opts.TLSConfig, opts.SecretData)
+}
+
+func weComNotifierFunc(opts notifierOptions) (Interface, error) {
+	return NewWeCom(opts.URL, opts.ProxyURL, opts.TLSConfig)
+}
+
+func syslogNotifierFunc(opts notifierOptions) (Interface, error) {
+	return NewSyslog(opts.URL, opts.TLSConfig, opts.ProviderSpec.Syslog)
+}
+
+func redisNotifierFunc(opts notifierOptions) (Interface, error) {
+	return NewRedis(opts.URL, opts.Channel, opts.Username, opts.Password, opts.TLSConfig, opts.ProviderSpec.Redis)
+}
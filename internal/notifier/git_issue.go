@@ -0,0 +1,96 @@
+/*
+Copyright 2026 The Flux authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notifier
+
+import (
+	"fmt"
+	"maps"
+	"strings"
+
+	eventv1 "github.com/fluxcd/pkg/apis/event/v1beta1"
+)
+
+const (
+	// issueLabelsKey is the event metadata key holding the
+	// comma-separated labels of the created issues.
+	issueLabelsKey = "issueLabels"
+
+	// issueAssigneesKey is the event metadata key holding the
+	// comma-separated usernames assigned to the created issues.
+	issueAssigneesKey = "issueAssignees"
+)
+
+// gitIssue contains shared logic for issue providers (GitHub Issue,
+// GitLab Issue, etc.). The issue of an involved object is identified by
+// the comment key marker in its body, see changeRequestComment.
+type gitIssue struct {
+	changeRequestComment
+}
+
+// newGitIssue returns a gitIssue for the given provider UID.
+func newGitIssue(providerUID string) gitIssue {
+	return gitIssue{
+		changeRequestComment: changeRequestComment{
+			ProviderUID:      providerUID,
+			CommentKeyPrefix: "flux-issue-key",
+		},
+	}
+}
+
+// shouldSkip returns true for the events that neither open nor close issues,
+// i.e. the events other than errors and the info events signaling a recovery.
+func (c *gitIssue) shouldSkip(event *eventv1.Event) bool {
+	return event.Severity != eventv1.EventSeverityError && !IsRecoveryEvent(event)
+}
+
+// formatIssueTitle formats the title of the issue opened for the event.
+func (c *gitIssue) formatIssueTitle(event *eventv1.Event) string {
+	return fmt.Sprintf("%s/%s/%s: %s",
+		event.InvolvedObject.Kind,
+		event.InvolvedObject.Namespace,
+		event.InvolvedObject.Name,
+		event.Reason)
+}
+
+// formatIssueBody formats the body of the issue, without the issue metadata keys.
+func (c *gitIssue) formatIssueBody(event *eventv1.Event) string {
+	e := *event
+	e.Metadata = maps.Clone(event.Metadata)
+	delete(e.Metadata, issueLabelsKey)
+	delete(e.Metadata, issueAssigneesKey)
+	return c.formatCommentBody(&e)
+}
+
+// issueLabels returns the labels from the event metadata.
+func (c *gitIssue) issueLabels(event *eventv1.Event) []string {
+	return splitIssueMetadata(event.Metadata[issueLabelsKey])
+}
+
+// issueAssignees returns the assignees from the event metadata.
+func (c *gitIssue) issueAssignees(event *eventv1.Event) []string {
+	return splitIssueMetadata(event.Metadata[issueAssigneesKey])
+}
+
+func splitIssueMetadata(value string) []string {
+	var values []string
+	for v := range strings.SplitSeq(value, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			values = append(values, v)
+		}
+	}
+	return values
+}
@@ -0,0 +1,85 @@
+/*
+Copyright 2026 The Flux authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notifier
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+
+	eventv1 "github.com/fluxcd/pkg/apis/event/v1beta1"
+	"github.com/fluxcd/pkg/apis/meta"
+)
+
+// testIssueEvents returns a failure, a recovery and a progressing event for the same object.
+func testIssueEvents() (eventv1.Event, eventv1.Event, eventv1.Event) {
+	failure := eventv1.Event{
+		InvolvedObject: corev1.ObjectReference{
+			Kind:      "Kustomization",
+			Namespace: "flux-system",
+			Name:      "apps",
+		},
+		Severity: eventv1.EventSeverityError,
+		Reason:   "HealthCheckFailed",
+		Message:  "timeout waiting for: [Deployment/apps/podinfo status: 'InProgress']",
+		Metadata: map[string]string{
+			"revision":        "main@sha1:abc",
+			issueLabelsKey:    "flux, incident",
+			issueAssigneesKey: "alice,bob",
+		},
+	}
+	recovery := failure
+	recovery.Severity = eventv1.EventSeverityInfo
+	recovery.Reason = meta.ReconciliationSucceededReason
+	recovery.Message = "Applied revision: main@sha1:def"
+	progressing := failure
+	progressing.Severity = eventv1.EventSeverityInfo
+	progressing.Reason = meta.ProgressingReason
+	return failure, recovery, progressing
+}
+
+func TestGitIssue(t *testing.T) {
+	g := NewWithT(t)
+
+	failure, recovery, progressing := testIssueEvents()
+	trace := failure
+	trace.Severity = eventv1.EventSeverityTrace
+	dependencyNotReady := failure
+	dependencyNotReady.Severity = eventv1.EventSeverityInfo
+	dependencyNotReady.Reason = meta.DependencyNotReadyReason
+	c := newGitIssue("0c9c2e41-d2f9-4f9b-9c41-bebc1984d67a")
+
+	g.Expect(c.shouldSkip(&failure)).To(BeFalse())
+	g.Expect(c.shouldSkip(&recovery)).To(BeFalse())
+	g.Expect(c.shouldSkip(&progressing)).To(BeTrue())
+	g.Expect(c.shouldSkip(&trace)).To(BeTrue())
+	g.Expect(c.shouldSkip(&dependencyNotReady)).To(BeTrue())
+
+	g.Expect(c.formatIssueTitle(&failure)).To(Equal("Kustomization/flux-system/apps: HealthCheckFailed"))
+	g.Expect(c.issueLabels(&failure)).To(Equal([]string{"flux", "incident"}))
+	g.Expect(c.issueAssignees(&failure)).To(Equal([]string{"alice", "bob"}))
+	g.Expect(c.issueLabels(&eventv1.Event{})).To(BeEmpty())
+
+	body := c.formatIssueBody(&failure)
+	g.Expect(body).To(HavePrefix("<!-- flux-issue-key:0c9c2e41-d2f9-4f9b-9c41-bebc1984d67a/Kustomization/flux-system/apps -->"))
+	g.Expect(body).To(ContainSubstring(failure.Message))
+	g.Expect(body).To(ContainSubstring("* `revision`: main@sha1:abc"))
+	g.Expect(body).ToNot(ContainSubstring(issueLabelsKey))
+	g.Expect(body).ToNot(ContainSubstring(issueAssigneesKey))
+	g.Expect(failure.Metadata).To(HaveKey(issueLabelsKey))
+}
@@ -0,0 +1,164 @@
+/*
+Copyright 2026 The Flux authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notifier
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"code.gitea.io/sdk/gitea"
+
+	eventv1 "github.com/fluxcd/pkg/apis/event/v1beta1"
+)
+
+type GiteaIssue struct {
+	gitIssue
+	Owner    string
+	Repo     string
+	Username string
+	Client   *gitea.Client
+}
+
+func NewGiteaIssue(providerUID string, opts ...GiteaClientOption) (*GiteaIssue, error) {
+	if providerUID == "" {
+		return nil, errors.New("provider UID cannot be empty")
+	}
+
+	opts = append(opts, WithGiteaFetchUserLogin())
+	clientInfo, err := NewGiteaClient(opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &GiteaIssue{
+		gitIssue: newGitIssue(providerUID),
+		Owner:    clientInfo.Owner,
+		Repo:     clientInfo.Repo,
+		Username: clientInfo.Username,
+		Client:   clientInfo.Client,
+	}, nil
+}
+
+// Post opens an issue when the involved object fails, or updates the body of
+// the open issue of the object (based on the comment key). When the object
+// recovers, the body of the open issue is updated and the issue is closed.
+func (g *GiteaIssue) Post(ctx context.Context, event eventv1.Event) error {
+	if g.shouldSkip(&event) {
+		return nil
+	}
+
+	issue, err := g.findOpenIssue(&event)
+	if err != nil {
+		return err
+	}
+
+	body := g.formatIssueBody(&event)
+	switch {
+	case issue == nil && IsRecoveryEvent(&event):
+		// Nothing to close.
+	case issue == nil:
+		opts := gitea.CreateIssueOption{
+			Title:     g.formatIssueTitle(&event),
+			Body:      body,
+			Assignees: g.issueAssignees(&event),
+		}
+		if labels := g.issueLabels(&event); len(labels) > 0 {
+			opts.Labels, err = g.getLabelIDs(labels)
+			if err != nil {
+				return err
+			}
+		}
+		if _, _, err := g.Client.CreateIssue(g.Owner, g.Repo, opts); err != nil {
+			return fmt.Errorf("failed to create issue: %w", err)
+		}
+	case IsRecoveryEvent(&event):
+		closed := gitea.StateClosed
+		if _, _, err := g.Client.EditIssue(g.Owner, g.Repo, issue.Index, gitea.EditIssueOption{
+			Body:  &body,
+			State: &closed,
+		}); err != nil {
+			return fmt.Errorf("failed to close issue: %w", err)
+		}
+	default:
+		if _, _, err := g.Client.EditIssue(g.Owner, g.Repo, issue.Index, gitea.EditIssueOption{
+			Body: &body,
+		}); err != nil {
+			return fmt.Errorf("failed to update issue: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// findOpenIssue returns the open issue created by the authenticated user
+// matching the comment key, or nil if there is none.
+func (g *GiteaIssue) findOpenIssue(event *eventv1.Event) (*gitea.Issue, error) {
+	// List the open issues created by the authenticated user, 100 per page.
+	opts := gitea.ListIssueOption{
+		ListOptions: gitea.ListOptions{
+			Page:     1,
+			PageSize: 100,
+		},
+		State:     gitea.StateOpen,
+		Type:      gitea.IssueTypeIssue,
+		CreatedBy: g.Username,
+	}
+
+	commentKeyMarker := g.formatCommentKeyMarker(event)
+	for {
+		issues, resp, err := g.Client.ListRepoIssues(g.Owner, g.Repo, opts)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list issues: %w", err)
+		}
+		for _, issue := range issues {
+			if strings.Contains(issue.Body, commentKeyMarker) {
+				return issue, nil
+			}
+		}
+		if resp == nil || resp.NextPage == 0 {
+			return nil, nil
+		}
+		opts.Page = resp.NextPage
+	}
+}
+
+// getLabelIDs resolves the IDs of the given label names, as required
+// by the Gitea API for labeling issues. Labels must exist in the repository.
+func (g *GiteaIssue) getLabelIDs(names []string) ([]int64, error) {
+	labels, _, err := g.Client.ListRepoLabels(g.Owner, g.Repo, gitea.ListLabelsOptions{
+		ListOptions: gitea.ListOptions{
+			Page:     1,
+			PageSize: 100,
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list labels: %w", err)
+	}
+
+	ids := make([]int64, 0, len(names))
+	for _, name := range names {
+		i := slices.IndexFunc(labels, func(l *gitea.Label) bool { return l.Name == name })
+		if i < 0 {
+			return nil, fmt.Errorf("label %q not found", name)
+		}
+		ids = append(ids, labels[i].ID)
+	}
+	return ids, nil
+}
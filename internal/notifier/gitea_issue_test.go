@@ -0,0 +1,204 @@
+/*
+Copyright 2026 The Flux authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notifier
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"code.gitea.io/sdk/gitea"
+	. "github.com/onsi/gomega"
+
+	eventv1 "github.com/fluxcd/pkg/apis/event/v1beta1"
+)
+
+func newGiteaIssueTestMux() *http.ServeMux {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/v1/user", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]any{"id": 1, "login": "test-user", "username": "test-user"})
+	})
+	mux.HandleFunc("GET /api/v1/version", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"version":"1.18.3"}`)
+	})
+	return mux
+}
+
+func TestNewGiteaIssueBasic(t *testing.T) {
+	g := NewWithT(t)
+
+	srv := httptest.NewServer(newGiteaIssueTestMux())
+	t.Cleanup(srv.Close)
+
+	gc, err := NewGiteaIssue("0c9c2e41-d2f9-4f9b-9c41-bebc1984d67a",
+		WithGiteaAddress(srv.URL+"/foo/bar"),
+		WithGiteaToken("foobar"),
+	)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(gc.Owner).To(Equal("foo"))
+	g.Expect(gc.Repo).To(Equal("bar"))
+	g.Expect(gc.ProviderUID).To(Equal("0c9c2e41-d2f9-4f9b-9c41-bebc1984d67a"))
+	g.Expect(gc.Username).To(Equal("test-user"))
+}
+
+func TestNewGiteaIssueEmptyToken(t *testing.T) {
+	g := NewWithT(t)
+	_, err := NewGiteaIssue("0c9c2e41-d2f9-4f9b-9c41-bebc1984d67a",
+		WithGiteaAddress("https://gitea.example.com/foo/bar"),
+	)
+	g.Expect(err).To(HaveOccurred())
+}
+
+func TestNewGiteaIssueEmptyProviderUID(t *testing.T) {
+	g := NewWithT(t)
+	_, err := NewGiteaIssue("",
+		WithGiteaAddress("https://gitea.example.com/foo/bar"),
+		WithGiteaToken("foobar"),
+	)
+	g.Expect(err).To(HaveOccurred())
+}
+
+func TestGiteaIssue_Post(t *testing.T) {
+	failure, recovery, progressing := testIssueEvents()
+	unknownLabel := failure
+	unknownLabel.Metadata = map[string]string{issueLabelsKey: "unknown"}
+
+	tests := []struct {
+		name           string
+		event          eventv1.Event
+		existing       bool
+		expectedCreate bool
+		expectedEdit   bool
+		expectedClose  bool
+		expectedErr    string
+	}{
+		{
+			name:           "failure opens an issue",
+			event:          failure,
+			expectedCreate: true,
+		},
+		{
+			name:        "labels must exist in the repository",
+			event:       unknownLabel,
+			expectedErr: `label "unknown" not found`,
+		},
+		{
+			name:         "repeated failure updates the open issue",
+			event:        failure,
+			existing:     true,
+			expectedEdit: true,
+		},
+		{
+			name:          "recovery closes the open issue",
+			event:         recovery,
+			existing:      true,
+			expectedEdit:  true,
+			expectedClose: true,
+		},
+		{
+			name:  "recovery without open issue is a no-op",
+			event: recovery,
+		},
+		{
+			name:     "progressing events are skipped",
+			event:    progressing,
+			existing: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			var created *gitea.CreateIssueOption
+			var edited *gitea.EditIssueOption
+			var marker string
+			mux := newGiteaIssueTestMux()
+			mux.HandleFunc("GET /api/v1/repos/foo/bar/labels", func(w http.ResponseWriter, r *http.Request) {
+				json.NewEncoder(w).Encode([]map[string]any{
+					{"id": 20, "name": "flux"},
+					{"id": 21, "name": "incident"},
+				})
+			})
+			mux.HandleFunc("GET /api/v1/repos/foo/bar/issues", func(w http.ResponseWriter, r *http.Request) {
+				g.Expect(r.URL.Query().Get("state")).To(Equal("open"))
+				g.Expect(r.URL.Query().Get("created_by")).To(Equal("test-user"))
+				// The open issue of the object is on the second page.
+				if r.URL.Query().Get("page") == "2" {
+					issues := []map[string]any{}
+					if tt.existing {
+						issues = append(issues, map[string]any{"id": 102, "number": 3, "body": marker})
+					}
+					json.NewEncoder(w).Encode(issues)
+					return
+				}
+				w.Header().Set("Link", fmt.Sprintf(`<http://%s%s?page=2>; rel="next"`, r.Host, r.URL.Path))
+				json.NewEncoder(w).Encode([]map[string]any{{"id": 100, "number": 1, "body": "unrelated"}})
+			})
+			mux.HandleFunc("POST /api/v1/repos/foo/bar/issues", func(w http.ResponseWriter, r *http.Request) {
+				created = &gitea.CreateIssueOption{}
+				g.Expect(json.NewDecoder(r.Body).Decode(created)).To(Succeed())
+				w.WriteHeader(http.StatusCreated)
+				json.NewEncoder(w).Encode(map[string]any{"id": 103, "number": 4})
+			})
+			mux.HandleFunc("PATCH /api/v1/repos/foo/bar/issues/3", func(w http.ResponseWriter, r *http.Request) {
+				edited = &gitea.EditIssueOption{}
+				g.Expect(json.NewDecoder(r.Body).Decode(edited)).To(Succeed())
+				w.WriteHeader(http.StatusCreated)
+				json.NewEncoder(w).Encode(map[string]any{"id": 102, "number": 3})
+			})
+			srv := httptest.NewServer(mux)
+			t.Cleanup(srv.Close)
+
+			gc, err := NewGiteaIssue("0c9c2e41-d2f9-4f9b-9c41-bebc1984d67a",
+				WithGiteaAddress(srv.URL+"/foo/bar"),
+				WithGiteaToken("foobar"),
+			)
+			g.Expect(err).ToNot(HaveOccurred())
+			marker = gc.formatCommentKeyMarker(&tt.event)
+
+			err = gc.Post(context.Background(), tt.event)
+			if tt.expectedErr != "" {
+				g.Expect(err).To(MatchError(tt.expectedErr))
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+
+			g.Expect(created != nil).To(Equal(tt.expectedCreate))
+			if tt.expectedCreate {
+				g.Expect(created.Title).To(Equal("Kustomization/flux-system/apps: HealthCheckFailed"))
+				g.Expect(created.Body).To(HavePrefix(marker))
+				g.Expect(created.Labels).To(Equal([]int64{20, 21}))
+				g.Expect(created.Assignees).To(Equal([]string{"alice", "bob"}))
+			}
+
+			g.Expect(edited != nil).To(Equal(tt.expectedEdit))
+			if tt.expectedEdit {
+				g.Expect(*edited.Body).To(HavePrefix(marker))
+				g.Expect(*edited.Body).To(ContainSubstring(tt.event.Message))
+				if tt.expectedClose {
+					g.Expect(*edited.State).To(Equal(gitea.StateClosed))
+				} else {
+					g.Expect(edited.State).To(BeNil())
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,138 @@
+/*
+Copyright 2026 The Flux authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notifier
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/go-github/v64/github"
+
+	eventv1 "github.com/fluxcd/pkg/apis/event/v1beta1"
+)
+
+type GitHubIssue struct {
+	gitIssue
+	Owner     string
+	Repo      string
+	UserLogin string
+	AppSlug   string
+	Client    *github.Client
+}
+
+func NewGitHubIssue(ctx context.Context, providerUID string, opts ...GitHubClientOption) (*GitHubIssue, error) {
+	if providerUID == "" {
+		return nil, errors.New("provider UID cannot be empty")
+	}
+
+	opts = append(opts, WithGitHubFetchUserLogin())
+	clientInfo, err := NewGitHubClient(ctx, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &GitHubIssue{
+		gitIssue:  newGitIssue(providerUID),
+		Owner:     clientInfo.Owner,
+		Repo:      clientInfo.Repo,
+		UserLogin: clientInfo.UserLogin,
+		AppSlug:   clientInfo.AppSlug,
+		Client:    clientInfo.Client,
+	}, nil
+}
+
+// Post opens an issue when the involved object fails, or updates the body of
+// the open issue of the object (based on the comment key). When the object
+// recovers, the body of the open issue is updated and the issue is closed.
+func (g *GitHubIssue) Post(ctx context.Context, event eventv1.Event) error {
+	if g.shouldSkip(&event) {
+		return nil
+	}
+
+	issue, err := g.findOpenIssue(ctx, &event)
+	if err != nil {
+		return err
+	}
+
+	body := g.formatIssueBody(&event)
+	switch {
+	case issue == nil && IsRecoveryEvent(&event):
+		// Nothing to close.
+	case issue == nil:
+		req := &github.IssueRequest{
+			Title: github.String(g.formatIssueTitle(&event)),
+			Body:  &body,
+		}
+		if labels := g.issueLabels(&event); len(labels) > 0 {
+			req.Labels = &labels
+		}
+		if assignees := g.issueAssignees(&event); len(assignees) > 0 {
+			req.Assignees = &assignees
+		}
+		if _, _, err := g.Client.Issues.Create(ctx, g.Owner, g.Repo, req); err != nil {
+			return fmt.Errorf("failed to create issue: %w", err)
+		}
+	case IsRecoveryEvent(&event):
+		if _, _, err := g.Client.Issues.Edit(ctx, g.Owner, g.Repo, issue.GetNumber(), &github.IssueRequest{
+			Body:        &body,
+			State:       github.String("closed"),
+			StateReason: github.String("completed"),
+		}); err != nil {
+			return fmt.Errorf("failed to close issue: %w", err)
+		}
+	default:
+		if _, _, err := g.Client.Issues.Edit(ctx, g.Owner, g.Repo, issue.GetNumber(), &github.IssueRequest{
+			Body: &body,
+		}); err != nil {
+			return fmt.Errorf("failed to update issue: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// findOpenIssue returns the open issue created by the authenticated user
+// matching the comment key, or nil if there is none.
+func (g *GitHubIssue) findOpenIssue(ctx context.Context, event *eventv1.Event) (*github.Issue, error) {
+	// List the open issues created by the authenticated user, 100 per page.
+	// 100 is the maximum value for per_page:
+	// https://docs.github.com/en/rest/issues/issues?apiVersion=2022-11-28#list-repository-issues
+	opts := &github.IssueListByRepoOptions{
+		State:       "open",
+		Creator:     gitHubUserLogin(g.UserLogin, g.AppSlug),
+		ListOptions: github.ListOptions{PerPage: 100},
+	}
+
+	commentKeyMarker := g.formatCommentKeyMarker(event)
+	for {
+		issues, resp, err := g.Client.Issues.ListByRepo(ctx, g.Owner, g.Repo, opts)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list issues: %w", err)
+		}
+		for _, issue := range issues {
+			if !issue.IsPullRequest() && strings.Contains(issue.GetBody(), commentKeyMarker) {
+				return issue, nil
+			}
+		}
+		if resp.NextPage == 0 {
+			return nil, nil
+		}
+		opts.Page = resp.NextPage
+	}
+}
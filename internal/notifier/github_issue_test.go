@@ -0,0 +1,196 @@
+/*
+Copyright 2026 The Flux authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notifier
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-github/v64/github"
+	. "github.com/onsi/gomega"
+
+	eventv1 "github.com/fluxcd/pkg/apis/event/v1beta1"
+)
+
+func TestNewGitHubIssueBasic(t *testing.T) {
+	g := NewWithT(t)
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v3/user" {
+			user := &github.User{Login: github.String("test-user")}
+			json.NewEncoder(w).Encode(user)
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+	}
+	srv := httptest.NewServer(http.HandlerFunc(handler))
+	t.Cleanup(srv.Close)
+
+	gh, err := NewGitHubIssue(context.Background(), "0c9c2e41-d2f9-4f9b-9c41-bebc1984d67a",
+		WithGitHubAddress(srv.URL+"/foo/bar"),
+		WithGitHubToken("foobar"),
+	)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(gh.Owner).To(Equal("foo"))
+	g.Expect(gh.Repo).To(Equal("bar"))
+	g.Expect(gh.ProviderUID).To(Equal("0c9c2e41-d2f9-4f9b-9c41-bebc1984d67a"))
+	g.Expect(gh.UserLogin).To(Equal("test-user"))
+}
+
+func TestNewGitHubIssueEmptyToken(t *testing.T) {
+	g := NewWithT(t)
+	_, err := NewGitHubIssue(context.Background(), "0c9c2e41-d2f9-4f9b-9c41-bebc1984d67a",
+		WithGitHubAddress("https://github.com/foo/bar"),
+	)
+	g.Expect(err).To(HaveOccurred())
+}
+
+func TestNewGitHubIssueEmptyProviderUID(t *testing.T) {
+	g := NewWithT(t)
+	_, err := NewGitHubIssue(context.Background(), "",
+		WithGitHubAddress("https://github.com/foo/bar"),
+		WithGitHubToken("foobar"),
+	)
+	g.Expect(err).To(HaveOccurred())
+}
+
+func TestGitHubIssue_Post(t *testing.T) {
+	failure, recovery, progressing := testIssueEvents()
+
+	tests := []struct {
+		name            string
+		event           eventv1.Event
+		existing        bool
+		expectedCreate  bool
+		expectedEdit    bool
+		expectedClose   bool
+		expectedListing bool
+	}{
+		{
+			name:            "failure opens an issue",
+			event:           failure,
+			expectedListing: true,
+			expectedCreate:  true,
+		},
+		{
+			name:            "repeated failure updates the open issue",
+			event:           failure,
+			existing:        true,
+			expectedListing: true,
+			expectedEdit:    true,
+		},
+		{
+			name:            "recovery closes the open issue",
+			event:           recovery,
+			existing:        true,
+			expectedListing: true,
+			expectedEdit:    true,
+			expectedClose:   true,
+		},
+		{
+			name:            "recovery without open issue is a no-op",
+			event:           recovery,
+			expectedListing: true,
+		},
+		{
+			name:     "progressing events are skipped",
+			event:    progressing,
+			existing: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			var listed bool
+			var created, edited *github.IssueRequest
+			var marker string
+			mux := http.NewServeMux()
+			mux.HandleFunc("GET /api/v3/user", func(w http.ResponseWriter, r *http.Request) {
+				json.NewEncoder(w).Encode(&github.User{Login: github.String("test-user")})
+			})
+			mux.HandleFunc("GET /api/v3/repos/foo/bar/issues", func(w http.ResponseWriter, r *http.Request) {
+				listed = true
+				g.Expect(r.URL.Query().Get("state")).To(Equal("open"))
+				g.Expect(r.URL.Query().Get("creator")).To(Equal("test-user"))
+				// The open issue of the object is on the second page.
+				if r.URL.Query().Get("page") == "2" {
+					var issues []*github.Issue
+					if tt.existing {
+						issues = append(issues, &github.Issue{Number: github.Int(3), Body: github.String(marker)})
+					}
+					json.NewEncoder(w).Encode(issues)
+					return
+				}
+				w.Header().Set("Link", fmt.Sprintf(`<http://%s%s?page=2>; rel="next"`, r.Host, r.URL.Path))
+				json.NewEncoder(w).Encode([]*github.Issue{
+					// Pull requests are returned by the issues API, and must be ignored.
+					{Number: github.Int(1), Body: github.String(marker), PullRequestLinks: &github.PullRequestLinks{}},
+					{Number: github.Int(2), Body: github.String("unrelated")},
+				})
+			})
+			mux.HandleFunc("POST /api/v3/repos/foo/bar/issues", func(w http.ResponseWriter, r *http.Request) {
+				created = &github.IssueRequest{}
+				g.Expect(json.NewDecoder(r.Body).Decode(created)).To(Succeed())
+				w.WriteHeader(http.StatusCreated)
+				json.NewEncoder(w).Encode(&github.Issue{Number: github.Int(4)})
+			})
+			mux.HandleFunc("PATCH /api/v3/repos/foo/bar/issues/3", func(w http.ResponseWriter, r *http.Request) {
+				edited = &github.IssueRequest{}
+				g.Expect(json.NewDecoder(r.Body).Decode(edited)).To(Succeed())
+				json.NewEncoder(w).Encode(&github.Issue{Number: github.Int(3)})
+			})
+			srv := httptest.NewServer(mux)
+			t.Cleanup(srv.Close)
+
+			gh, err := NewGitHubIssue(context.Background(), "0c9c2e41-d2f9-4f9b-9c41-bebc1984d67a",
+				WithGitHubAddress(srv.URL+"/foo/bar"),
+				WithGitHubToken("foobar"),
+			)
+			g.Expect(err).ToNot(HaveOccurred())
+			marker = gh.formatCommentKeyMarker(&tt.event)
+
+			g.Expect(gh.Post(context.Background(), tt.event)).To(Succeed())
+			g.Expect(listed).To(Equal(tt.expectedListing))
+
+			g.Expect(created != nil).To(Equal(tt.expectedCreate))
+			if tt.expectedCreate {
+				g.Expect(created.GetTitle()).To(Equal("Kustomization/flux-system/apps: HealthCheckFailed"))
+				g.Expect(created.GetBody()).To(HavePrefix(marker))
+				g.Expect(created.GetLabels()).To(Equal([]string{"flux", "incident"}))
+				g.Expect(created.GetAssignees()).To(Equal([]string{"alice", "bob"}))
+			}
+
+			g.Expect(edited != nil).To(Equal(tt.expectedEdit))
+			if tt.expectedEdit {
+				g.Expect(edited.GetBody()).To(HavePrefix(marker))
+				g.Expect(edited.GetBody()).To(ContainSubstring(tt.event.Message))
+				if tt.expectedClose {
+					g.Expect(edited.GetState()).To(Equal("closed"))
+					g.Expect(edited.GetStateReason()).To(Equal("completed"))
+				} else {
+					g.Expect(edited.State).To(BeNil())
+				}
+			}
+		})
+	}
+}
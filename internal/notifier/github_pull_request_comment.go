@@ -121,11 +121,17 @@ func (g *GitHubPullRequestComment) Post(ctx context.Context, event eventv1.Event
 // getUserLogin returns the login of the authenticated user (PAT or GitHub App)
 // as it appears in the GitHub API object representing pull request comments.
 func (g *GitHubPullRequestComment) getUserLogin() string {
-	if g.AppSlug != "" {
+	return gitHubUserLogin(g.UserLogin, g.AppSlug)
+}
+
+// gitHubUserLogin returns the login of the authenticated user, or of the
+// GitHub App bot user if the app slug is set.
+func gitHubUserLogin(userLogin, appSlug string) string {
+	if appSlug != "" {
 		// GitHub App comments appear as "<app-slug>[bot]".
-		return fmt.Sprintf("%s[bot]", g.AppSlug)
+		return fmt.Sprintf("%s[bot]", appSlug)
 	}
-	return g.UserLogin
+	return userLogin
 }
 
 // getPullRequestNumber extracts the pull request number from the event
@@ -0,0 +1,181 @@
+/*
+Copyright 2026 The Flux authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notifier
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	eventv1 "github.com/fluxcd/pkg/apis/event/v1beta1"
+)
+
+type GitLabIssue struct {
+	gitIssue
+	ProjectID string
+	Username  string
+	Client    *gitlab.Client
+}
+
+func NewGitLabIssue(providerUID string, addr string, token string, tlsConfig *tls.Config) (*GitLabIssue, error) {
+	if providerUID == "" {
+		return nil, errors.New("provider UID cannot be empty")
+	}
+
+	if token == "" {
+		return nil, errors.New("gitlab token cannot be empty")
+	}
+
+	host, id, err := parseGitAddress(addr)
+	if err != nil {
+		return nil, err
+	}
+
+	opts := []gitlab.ClientOptionFunc{gitlab.WithBaseURL(host)}
+	if tlsConfig != nil {
+		tr := &http.Transport{
+			TLSClientConfig: tlsConfig,
+		}
+		hc := &http.Client{Transport: tr}
+		opts = append(opts, gitlab.WithHTTPClient(hc))
+	}
+
+	client, err := gitlab.NewClient(token, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	// Fetch the authenticated user's username
+	user, _, err := client.Users.CurrentUser()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get authenticated user info: %w", err)
+	}
+
+	return &GitLabIssue{
+		gitIssue:  newGitIssue(providerUID),
+		ProjectID: id,
+		Username:  user.Username,
+		Client:    client,
+	}, nil
+}
+
+// Post opens an issue when the involved object fails, or updates the description
+// of the open issue of the object (based on the comment key). When the object
+// recovers, the description of the open issue is updated and the issue is closed.
+func (g *GitLabIssue) Post(ctx context.Context, event eventv1.Event) error {
+	if g.shouldSkip(&event) {
+		return nil
+	}
+
+	issue, err := g.findOpenIssue(ctx, &event)
+	if err != nil {
+		return err
+	}
+
+	body := g.formatIssueBody(&event)
+	switch {
+	case issue == nil && IsRecoveryEvent(&event):
+		// Nothing to close.
+	case issue == nil:
+		opts := &gitlab.CreateIssueOptions{
+			Title:       gitlab.Ptr(g.formatIssueTitle(&event)),
+			Description: &body,
+		}
+		if labels := g.issueLabels(&event); len(labels) > 0 {
+			opts.Labels = gitlab.Ptr(gitlab.LabelOptions(labels))
+		}
+		if assignees := g.issueAssignees(&event); len(assignees) > 0 {
+			ids, err := g.getUserIDs(ctx, assignees)
+			if err != nil {
+				return err
+			}
+			opts.AssigneeIDs = &ids
+		}
+		if _, _, err := g.Client.Issues.CreateIssue(g.ProjectID, opts, gitlab.WithContext(ctx)); err != nil {
+			return fmt.Errorf("failed to create issue: %w", err)
+		}
+	case IsRecoveryEvent(&event):
+		if _, _, err := g.Client.Issues.UpdateIssue(g.ProjectID, issue.IID, &gitlab.UpdateIssueOptions{
+			Description: &body,
+			StateEvent:  gitlab.Ptr("close"),
+		}, gitlab.WithContext(ctx)); err != nil {
+			return fmt.Errorf("failed to close issue: %w", err)
+		}
+	default:
+		if _, _, err := g.Client.Issues.UpdateIssue(g.ProjectID, issue.IID, &gitlab.UpdateIssueOptions{
+			Description: &body,
+		}, gitlab.WithContext(ctx)); err != nil {
+			return fmt.Errorf("failed to update issue: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// findOpenIssue returns the open issue created by the authenticated user
+// matching the comment key, or nil if there is none.
+func (g *GitLabIssue) findOpenIssue(ctx context.Context, event *eventv1.Event) (*gitlab.Issue, error) {
+	// List the open issues created by the authenticated user, 100 per page.
+	// 100 is the maximum value for per_page:
+	// https://docs.gitlab.com/api/rest/#pagination
+	opts := &gitlab.ListProjectIssuesOptions{
+		State:          gitlab.Ptr("opened"),
+		AuthorUsername: gitlab.Ptr(g.Username),
+		ListOptions:    gitlab.ListOptions{PerPage: 100},
+	}
+
+	commentKeyMarker := g.formatCommentKeyMarker(event)
+	for {
+		issues, resp, err := g.Client.Issues.ListProjectIssues(g.ProjectID, opts, gitlab.WithContext(ctx))
+		if err != nil {
+			return nil, fmt.Errorf("failed to list issues: %w", err)
+		}
+		for _, issue := range issues {
+			if strings.Contains(issue.Description, commentKeyMarker) {
+				return issue, nil
+			}
+		}
+		if resp.NextPage == 0 {
+			return nil, nil
+		}
+		opts.Page = resp.NextPage
+	}
+}
+
+// getUserIDs resolves the IDs of the given usernames, as required
+// by the GitLab API for assigning issues.
+func (g *GitLabIssue) getUserIDs(ctx context.Context, usernames []string) ([]int64, error) {
+	ids := make([]int64, 0, len(usernames))
+	for _, username := range usernames {
+		users, _, err := g.Client.Users.ListUsers(&gitlab.ListUsersOptions{
+			Username: gitlab.Ptr(username),
+		}, gitlab.WithContext(ctx))
+		if err != nil {
+			return nil, fmt.Errorf("failed to get user %q: %w", username, err)
+		}
+		if len(users) == 0 {
+			return nil, fmt.Errorf("user %q not found", username)
+		}
+		ids = append(ids, users[0].ID)
+	}
+	return ids, nil
+}
@@ -0,0 +1,175 @@
+/*
+Copyright 2026 The Flux authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notifier
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	eventv1 "github.com/fluxcd/pkg/apis/event/v1beta1"
+)
+
+func TestNewGitLabIssueBasic(t *testing.T) {
+	g := NewWithT(t)
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v4/user" {
+			user := map[string]interface{}{
+				"id":       1,
+				"username": "test-user",
+			}
+			json.NewEncoder(w).Encode(user)
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+	}
+	srv := httptest.NewServer(http.HandlerFunc(handler))
+	t.Cleanup(srv.Close)
+
+	gl, err := NewGitLabIssue("0c9c2e41-d2f9-4f9b-9c41-bebc1984d67a", srv.URL+"/foo/bar/baz", "foobar", nil)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(gl.ProjectID).To(Equal("foo/bar/baz"))
+	g.Expect(gl.ProviderUID).To(Equal("0c9c2e41-d2f9-4f9b-9c41-bebc1984d67a"))
+	g.Expect(gl.Username).To(Equal("test-user"))
+}
+
+func TestNewGitLabIssueEmptyToken(t *testing.T) {
+	g := NewWithT(t)
+	_, err := NewGitLabIssue("0c9c2e41-d2f9-4f9b-9c41-bebc1984d67a", "https://gitlab.com/foo/bar", "", nil)
+	g.Expect(err).To(HaveOccurred())
+}
+
+func TestNewGitLabIssueEmptyProviderUID(t *testing.T) {
+	g := NewWithT(t)
+	_, err := NewGitLabIssue("", "https://gitlab.com/foo/bar", "foobar", nil)
+	g.Expect(err).To(HaveOccurred())
+}
+
+func TestGitLabIssue_Post(t *testing.T) {
+	failure, recovery, progressing := testIssueEvents()
+
+	tests := []struct {
+		name           string
+		event          eventv1.Event
+		existing       bool
+		expectedCreate bool
+		expectedUpdate bool
+		expectedClose  bool
+	}{
+		{
+			name:           "failure opens an issue",
+			event:          failure,
+			expectedCreate: true,
+		},
+		{
+			name:           "repeated failure updates the open issue",
+			event:          failure,
+			existing:       true,
+			expectedUpdate: true,
+		},
+		{
+			name:           "recovery closes the open issue",
+			event:          recovery,
+			existing:       true,
+			expectedUpdate: true,
+			expectedClose:  true,
+		},
+		{
+			name:  "recovery without open issue is a no-op",
+			event: recovery,
+		},
+		{
+			name:     "progressing events are skipped",
+			event:    progressing,
+			existing: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			var created, updated map[string]any
+			var marker string
+			mux := http.NewServeMux()
+			mux.HandleFunc("GET /api/v4/user", func(w http.ResponseWriter, r *http.Request) {
+				json.NewEncoder(w).Encode(map[string]any{"id": 1, "username": "test-user"})
+			})
+			mux.HandleFunc("GET /api/v4/users", func(w http.ResponseWriter, r *http.Request) {
+				ids := map[string]int{"alice": 10, "bob": 11}
+				username := r.URL.Query().Get("username")
+				json.NewEncoder(w).Encode([]map[string]any{{"id": ids[username], "username": username}})
+			})
+			mux.HandleFunc("GET /api/v4/projects/foo%2Fbar/issues", func(w http.ResponseWriter, r *http.Request) {
+				g.Expect(r.URL.Query().Get("state")).To(Equal("opened"))
+				g.Expect(r.URL.Query().Get("author_username")).To(Equal("test-user"))
+				// The open issue of the object is on the second page.
+				if r.URL.Query().Get("page") == "2" {
+					issues := []map[string]any{}
+					if tt.existing {
+						issues = append(issues, map[string]any{"id": 102, "iid": 3, "description": marker})
+					}
+					json.NewEncoder(w).Encode(issues)
+					return
+				}
+				w.Header().Set("X-Next-Page", "2")
+				json.NewEncoder(w).Encode([]map[string]any{{"id": 100, "iid": 1, "description": "unrelated"}})
+			})
+			mux.HandleFunc("POST /api/v4/projects/foo%2Fbar/issues", func(w http.ResponseWriter, r *http.Request) {
+				g.Expect(json.NewDecoder(r.Body).Decode(&created)).To(Succeed())
+				w.WriteHeader(http.StatusCreated)
+				json.NewEncoder(w).Encode(map[string]any{"id": 103, "iid": 4})
+			})
+			mux.HandleFunc("PUT /api/v4/projects/foo%2Fbar/issues/3", func(w http.ResponseWriter, r *http.Request) {
+				g.Expect(json.NewDecoder(r.Body).Decode(&updated)).To(Succeed())
+				json.NewEncoder(w).Encode(map[string]any{"id": 102, "iid": 3})
+			})
+			srv := httptest.NewServer(mux)
+			t.Cleanup(srv.Close)
+
+			gl, err := NewGitLabIssue("0c9c2e41-d2f9-4f9b-9c41-bebc1984d67a", srv.URL+"/foo/bar", "foobar", nil)
+			g.Expect(err).ToNot(HaveOccurred())
+			marker = gl.formatCommentKeyMarker(&tt.event)
+
+			g.Expect(gl.Post(context.Background(), tt.event)).To(Succeed())
+
+			g.Expect(created != nil).To(Equal(tt.expectedCreate))
+			if tt.expectedCreate {
+				g.Expect(created["title"]).To(Equal("Kustomization/flux-system/apps: HealthCheckFailed"))
+				g.Expect(created["description"]).To(HavePrefix(marker))
+				g.Expect(created["labels"]).To(Equal("flux,incident"))
+				g.Expect(created["assignee_ids"]).To(Equal([]any{float64(10), float64(11)}))
+			}
+
+			g.Expect(updated != nil).To(Equal(tt.expectedUpdate))
+			if tt.expectedUpdate {
+				g.Expect(updated["description"]).To(HavePrefix(marker))
+				g.Expect(updated["description"]).To(ContainSubstring(tt.event.Message))
+				if tt.expectedClose {
+					g.Expect(updated["state_event"]).To(Equal("close"))
+				} else {
+					g.Expect(updated).ToNot(HaveKey("state_event"))
+				}
+			}
+		})
+	}
+}
@@ -58,6 +58,24 @@ type GoogleChatCardSection struct {
 type GoogleChatCardWidget struct {
 	TextParagraph *GoogleChatCardWidgetTextParagraph `json:"textParagraph"`
 	KeyValue      *GoogleChatCardWidgetKeyValue      `json:"keyValue"`
+	Buttons       []GoogleChatCardWidgetButton       `json:"buttons,omitempty"`
+}
+
+type GoogleChatCardWidgetButton struct {
+	TextButton GoogleChatCardWidgetTextButton `json:"textButton"`
+}
+
+type GoogleChatCardWidgetTextButton struct {
+	Text    string                `json:"text"`
+	OnClick GoogleChatCardOnClick `json:"onClick"`
+}
+
+type GoogleChatCardOnClick struct {
+	OpenLink GoogleChatCardOpenLink `json:"openLink"`
+}
+
+type GoogleChatCardOpenLink struct {
+	URL string `json:"url"`
 }
 
 type GoogleChatCardWidgetTextParagraph struct {
@@ -128,6 +146,24 @@ func (s *GoogleChat) Post(ctx context.Context, event eventv1.Event) error {
 		})
 	}
 
+	// Links
+	if links := GetLinks(ctx); len(links) > 0 {
+		buttons := make([]GoogleChatCardWidgetButton, 0, len(links))
+		for _, l := range links {
+			buttons = append(buttons, GoogleChatCardWidgetButton{
+				TextButton: GoogleChatCardWidgetTextButton{
+					Text: l.Name,
+					OnClick: GoogleChatCardOnClick{
+						OpenLink: GoogleChatCardOpenLink{URL: l.URL},
+					},
+				},
+			})
+		}
+		sections = append(sections, GoogleChatCardSection{
+			Widgets: []GoogleChatCardWidget{{Buttons: buttons}},
+		})
+	}
+
 	card := GoogleChatCard{
 		Header:   header,
 		Sections: sections,
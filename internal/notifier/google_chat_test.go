@@ -50,3 +50,26 @@ func TestGoogleChat_Post(t *testing.T) {
 	err = google_chat.Post(context.TODO(), testEvent())
 	g.Expect(err).ToNot(HaveOccurred())
 }
+
+func TestGoogleChat_PostLinks(t *testing.T) {
+	g := NewWithT(t)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, err := io.ReadAll(r.Body)
+		g.Expect(err).ToNot(HaveOccurred())
+		var payload = GoogleChatPayload{}
+		err = json.Unmarshal(b, &payload)
+		g.Expect(err).ToNot(HaveOccurred())
+
+		button := payload.Cards[0].Sections[2].Widgets[0].Buttons[0]
+		g.Expect(button.TextButton.Text).To(Equal("Dashboard"))
+		g.Expect(button.TextButton.OnClick.OpenLink.URL).To(Equal("https://dashboard.example.com"))
+	}))
+	defer ts.Close()
+
+	google_chat, err := NewGoogleChat(ts.URL, "")
+	g.Expect(err).ToNot(HaveOccurred())
+
+	ctx := WithLinks(context.TODO(), []Link{{Name: "Dashboard", URL: "https://dashboard.example.com"}})
+	err = google_chat.Post(ctx, testEvent())
+	g.Expect(err).ToNot(HaveOccurred())
+}
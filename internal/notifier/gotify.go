@@ -0,0 +1,123 @@
+/*
+Copyright 2026 The Flux authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notifier
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net/url"
+
+	eventv1 "github.com/fluxcd/pkg/apis/event/v1beta1"
+	"github.com/hashicorp/go-retryablehttp"
+)
+
+// Gotify holds the Gotify server URL and the application token.
+type Gotify struct {
+	URL       string
+	ProxyURL  string
+	Token     string
+	TLSConfig *tls.Config
+}
+
+// GotifyPayload holds the message created with the Gotify API.
+// See https://gotify.net/api-docs#/message/createMessage.
+type GotifyPayload struct {
+	Title    string         `json:"title"`
+	Message  string         `json:"message"`
+	Priority int            `json:"priority"`
+	Extras   map[string]any `json:"extras,omitempty"`
+}
+
+// NewGotify creates a new Gotify notifier.
+//
+// Parameters:
+//   - address: Gotify server URL (e.g., "https://gotify.example.com")
+//   - proxyURL: Proxy URL (optional)
+//   - tlsConfig: TLS configuration (optional)
+//   - token: application token
+//
+// Returns an error if the address is invalid or the token is empty.
+func NewGotify(address string, proxyURL string, tlsConfig *tls.Config, token string) (*Gotify, error) {
+	if _, err := url.ParseRequestURI(address); err != nil {
+		return nil, fmt.Errorf("invalid Gotify server URL (address) %q: %w", address, err)
+	}
+	if token == "" {
+		return nil, errors.New("Gotify application token cannot be empty")
+	}
+
+	apiURL, err := url.JoinPath(address, "message")
+	if err != nil {
+		return nil, fmt.Errorf("failed to construct API URL: %w", err)
+	}
+
+	return &Gotify{
+		URL:       apiURL,
+		ProxyURL:  proxyURL,
+		Token:     token,
+		TLSConfig: tlsConfig,
+	}, nil
+}
+
+// Post creates a Gotify message for the event, with the priority and the
+// title emoji set by severity, and the first link as click URL.
+func (g *Gotify) Post(ctx context.Context, event eventv1.Event) error {
+	title, message := formatPushNotification(event)
+	payload := GotifyPayload{
+		Message: message,
+		Extras: map[string]any{
+			"client::display": map[string]string{"contentType": "text/plain"},
+		},
+	}
+
+	switch event.Severity {
+	case eventv1.EventSeverityError:
+		payload.Title = "🚨 " + title
+		payload.Priority = 8
+	case eventv1.EventSeverityTrace:
+		payload.Title = "🔍 " + title
+		payload.Priority = 2
+	default:
+		payload.Title = "💫 " + title
+		payload.Priority = 5
+	}
+
+	if links := GetLinks(ctx); len(links) > 0 {
+		payload.Extras["client::notification"] = map[string]any{
+			"click": map[string]string{"url": links[0].URL},
+		}
+	}
+
+	opts := []postOption{
+		withRequestModifier(func(req *retryablehttp.Request) {
+			req.Header.Set("X-Gotify-Key", g.Token)
+		}),
+	}
+	if g.ProxyURL != "" {
+		opts = append(opts, withProxy(g.ProxyURL))
+	}
+	if g.TLSConfig != nil {
+		opts = append(opts, withTLSConfig(g.TLSConfig))
+	}
+
+	if err := postMessage(ctx, g.URL, payload, opts...); err != nil {
+		return fmt.Errorf("postMessage failed: %w", err)
+	}
+
+	return nil
+}
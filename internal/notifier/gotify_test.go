@@ -0,0 +1,110 @@
+/*
+Copyright 2026 The Flux authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notifier
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	eventv1 "github.com/fluxcd/pkg/apis/event/v1beta1"
+)
+
+func TestNewGotify(t *testing.T) {
+	tests := []struct {
+		name        string
+		address     string
+		token       string
+		expectedErr string
+		expectedURL string
+	}{
+		{
+			name:        "invalid address",
+			address:     "gotify",
+			token:       "token",
+			expectedErr: "invalid Gotify server URL (address)",
+		},
+		{
+			name:        "token is required",
+			address:     "https://gotify.example.com",
+			expectedErr: "Gotify application token cannot be empty",
+		},
+		{
+			name:        "server URL with path",
+			address:     "https://example.com/gotify/",
+			token:       "token",
+			expectedURL: "https://example.com/gotify/message",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			gotify, err := NewGotify(tt.address, "", nil, tt.token)
+			if tt.expectedErr != "" {
+				g.Expect(err).To(MatchError(ContainSubstring(tt.expectedErr)))
+				g.Expect(gotify).To(BeNil())
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(gotify.URL).To(Equal(tt.expectedURL))
+		})
+	}
+}
+
+func TestGotify_Post(t *testing.T) {
+	g := NewWithT(t)
+
+	var payload map[string]any
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		g.Expect(r.URL.Path).To(Equal("/message"))
+		g.Expect(r.Header.Get("X-Gotify-Key")).To(Equal("app-token"))
+		g.Expect(json.NewDecoder(r.Body).Decode(&payload)).To(Succeed())
+	}))
+	defer ts.Close()
+
+	gotify, err := NewGotify(ts.URL, "", nil, "app-token")
+	g.Expect(err).ToNot(HaveOccurred())
+
+	event := testEvent()
+	event.Severity = eventv1.EventSeverityError
+	ctx := WithLinks(context.TODO(), []Link{{Name: "Dashboard", URL: "https://flux.example.com"}})
+	g.Expect(gotify.Post(ctx, event)).To(Succeed())
+
+	g.Expect(payload).To(Equal(map[string]any{
+		"title":    "🚨 gitrepository/webapp.gitops-system",
+		"message":  "message\n\ntest: metadata",
+		"priority": float64(8),
+		"extras": map[string]any{
+			"client::display": map[string]any{"contentType": "text/plain"},
+			"client::notification": map[string]any{
+				"click": map[string]any{"url": "https://flux.example.com"},
+			},
+		},
+	}))
+
+	event.Severity = eventv1.EventSeverityInfo
+	g.Expect(gotify.Post(context.TODO(), event)).To(Succeed())
+	g.Expect(payload["title"]).To(Equal("💫 gitrepository/webapp.gitops-system"))
+	g.Expect(payload["priority"]).To(Equal(float64(5)))
+	g.Expect(payload["extras"]).ToNot(HaveKey("client::notification"))
+}
@@ -0,0 +1,109 @@
+/*
+Copyright 2026 The Flux authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notifier
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net/url"
+
+	eventv1 "github.com/fluxcd/pkg/apis/event/v1beta1"
+	"github.com/fluxcd/pkg/apis/meta"
+)
+
+// GrafanaOnCall holds the URL of a Grafana OnCall formatted webhook integration.
+type GrafanaOnCall struct {
+	URL       string
+	ProxyURL  string
+	TLSConfig *tls.Config
+}
+
+// GrafanaOnCallAlert holds an alert of a Grafana OnCall formatted webhook integration.
+// See https://grafana.com/docs/oncall/latest/configure/integrations/references/webhook/.
+type GrafanaOnCallAlert struct {
+	AlertUID              string            `json:"alert_uid"`
+	Title                 string            `json:"title"`
+	State                 string            `json:"state"`
+	Message               string            `json:"message"`
+	LinkToUpstreamDetails string            `json:"link_to_upstream_details,omitempty"`
+	Metadata              map[string]string `json:"metadata,omitempty"`
+}
+
+// NewGrafanaOnCall creates a new Grafana OnCall notifier.
+//
+// Parameters:
+//   - address: formatted webhook integration URL
+//     (e.g., "https://oncall-prod-us-central-0.grafana.net/oncall/integrations/v1/formatted_webhook/<token>/")
+//   - proxyURL: Proxy URL (optional)
+//   - tlsConfig: TLS configuration (optional)
+//
+// Returns an error if the address is invalid.
+func NewGrafanaOnCall(address string, proxyURL string, tlsConfig *tls.Config) (*GrafanaOnCall, error) {
+	if _, err := url.ParseRequestURI(address); err != nil {
+		return nil, fmt.Errorf("invalid Grafana OnCall integration URL (address) %q: %w", address, err)
+	}
+
+	return &GrafanaOnCall{
+		URL:       address,
+		ProxyURL:  proxyURL,
+		TLSConfig: tlsConfig,
+	}, nil
+}
+
+// Post sends an alerting alert for error events, and resolves the alert
+// group of the involved object for the other events.
+func (g *GrafanaOnCall) Post(ctx context.Context, event eventv1.Event) error {
+	// skip progressing events (we want success or failure)
+	if event.HasReason(meta.ProgressingReason) {
+		return nil
+	}
+
+	var opts []postOption
+	if g.ProxyURL != "" {
+		opts = append(opts, withProxy(g.ProxyURL))
+	}
+	if g.TLSConfig != nil {
+		opts = append(opts, withTLSConfig(g.TLSConfig))
+	}
+
+	if err := postMessage(ctx, g.URL, toGrafanaOnCallAlert(event, GetLinks(ctx)), opts...); err != nil {
+		return fmt.Errorf("failed sending alert: %w", err)
+	}
+
+	return nil
+}
+
+func toGrafanaOnCallAlert(event eventv1.Event, links []Link) GrafanaOnCallAlert {
+	name, desc := formatNameAndDescription(event)
+	// Resolve just in case an existing alert group is firing
+	a := GrafanaOnCallAlert{
+		AlertUID: involvedObjectID(event),
+		Title:    desc + ": " + name,
+		State:    "ok",
+		Message:  event.Message + markdownLinks(links),
+		Metadata: event.Metadata,
+	}
+	// Fire an alert for errors
+	if event.Severity == eventv1.EventSeverityError {
+		a.State = "alerting"
+	}
+	if len(links) > 0 {
+		a.LinkToUpstreamDetails = links[0].URL
+	}
+	return a
+}
@@ -0,0 +1,102 @@
+/*
+Copyright 2026 The Flux authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notifier
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+
+	eventv1 "github.com/fluxcd/pkg/apis/event/v1beta1"
+	"github.com/fluxcd/pkg/apis/meta"
+)
+
+func TestNewGrafanaOnCall(t *testing.T) {
+	g := NewWithT(t)
+
+	_, err := NewGrafanaOnCall("oncall", "", nil)
+	g.Expect(err).To(MatchError(ContainSubstring("invalid Grafana OnCall integration URL (address)")))
+
+	address := "https://oncall-prod-us-central-0.grafana.net/oncall/integrations/v1/formatted_webhook/token/"
+	o, err := NewGrafanaOnCall(address, "", nil)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(o.URL).To(Equal(address))
+}
+
+func TestGrafanaOnCall_Post(t *testing.T) {
+	g := NewWithT(t)
+
+	var alerts []GrafanaOnCallAlert
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var alert GrafanaOnCallAlert
+		g.Expect(json.NewDecoder(r.Body).Decode(&alert)).To(Succeed())
+		alerts = append(alerts, alert)
+	}))
+	defer ts.Close()
+
+	o, err := NewGrafanaOnCall(ts.URL, "", nil)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	event := testEvent()
+	event.Severity = eventv1.EventSeverityError
+	g.Expect(o.Post(context.TODO(), event)).To(Succeed())
+
+	progressing := testEvent()
+	progressing.Reason = meta.ProgressingReason
+	g.Expect(o.Post(context.TODO(), progressing)).To(Succeed())
+
+	g.Expect(o.Post(context.TODO(), testEvent())).To(Succeed())
+
+	g.Expect(alerts).To(HaveLen(2))
+	g.Expect(alerts[0].State).To(Equal("alerting"))
+	g.Expect(alerts[1].State).To(Equal("ok"))
+	g.Expect(alerts[1].AlertUID).To(Equal(alerts[0].AlertUID))
+}
+
+func TestToGrafanaOnCallAlert(t *testing.T) {
+	g := NewWithT(t)
+
+	event := eventv1.Event{
+		InvolvedObject: corev1.ObjectReference{
+			Kind:      "Kustomization",
+			Namespace: "flux-system",
+			Name:      "apps",
+		},
+		Severity: eventv1.EventSeverityError,
+		Reason:   "HealthCheckFailed",
+		Message:  "timeout waiting for Deployment/apps/podinfo",
+		Metadata: map[string]string{"revision": "main@sha1:e1f2a3b4"},
+	}
+	links := []Link{{Name: "Dashboard", URL: "https://flux.example.com"}}
+
+	g.Expect(toGrafanaOnCallAlert(event, links)).To(Equal(GrafanaOnCallAlert{
+		AlertUID:              sha1String("Kustomization/flux-system/apps"),
+		Title:                 "health check failed: kustomization/apps",
+		State:                 "alerting",
+		Message:               "timeout waiting for Deployment/apps/podinfo\n\n[Dashboard](https://flux.example.com)",
+		LinkToUpstreamDetails: "https://flux.example.com",
+		Metadata:              map[string]string{"revision": "main@sha1:e1f2a3b4"},
+	}))
+
+	event.Severity = eventv1.EventSeverityInfo
+	g.Expect(toGrafanaOnCallAlert(event, nil).State).To(Equal("ok"))
+}
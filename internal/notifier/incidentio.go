@@ -0,0 +1,131 @@
+/*
+Copyright 2026 The Flux authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notifier
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net/url"
+
+	eventv1 "github.com/fluxcd/pkg/apis/event/v1beta1"
+	"github.com/fluxcd/pkg/apis/meta"
+	"github.com/hashicorp/go-retryablehttp"
+)
+
+// IncidentIO holds the URL and the token of an incident.io HTTP alert source.
+type IncidentIO struct {
+	URL       string
+	ProxyURL  string
+	Token     string
+	TLSConfig *tls.Config
+}
+
+// IncidentIOAlertEvent holds an alert event of an incident.io HTTP alert source.
+// See https://api-docs.incident.io/tag/Alert-Events-V2.
+type IncidentIOAlertEvent struct {
+	Title            string            `json:"title"`
+	Description      string            `json:"description"`
+	DeduplicationKey string            `json:"deduplication_key"`
+	Status           string            `json:"status"`
+	SourceURL        string            `json:"source_url,omitempty"`
+	Metadata         map[string]string `json:"metadata"`
+}
+
+// NewIncidentIO creates a new incident.io notifier.
+//
+// Parameters:
+//   - address: HTTP alert source URL
+//     (e.g., "https://api.incident.io/v2/alert_events/http/<alert-source-config-id>")
+//   - proxyURL: Proxy URL (optional)
+//   - tlsConfig: TLS configuration (optional)
+//   - token: alert source token
+//
+// Returns an error if the address is invalid or the token is empty.
+func NewIncidentIO(address string, proxyURL string, tlsConfig *tls.Config, token string) (*IncidentIO, error) {
+	if _, err := url.ParseRequestURI(address); err != nil {
+		return nil, fmt.Errorf("invalid incident.io alert source URL (address) %q: %w", address, err)
+	}
+	if token == "" {
+		return nil, errors.New("incident.io alert source token cannot be empty")
+	}
+
+	return &IncidentIO{
+		URL:       address,
+		ProxyURL:  proxyURL,
+		Token:     token,
+		TLSConfig: tlsConfig,
+	}, nil
+}
+
+// Post sends a firing alert event for error events, and resolves the alert
+// of the involved object for the other events.
+func (i *IncidentIO) Post(ctx context.Context, event eventv1.Event) error {
+	// skip progressing events (we want success or failure)
+	if event.HasReason(meta.ProgressingReason) {
+		return nil
+	}
+
+	opts := []postOption{
+		withRequestModifier(func(req *retryablehttp.Request) {
+			req.Header.Set("Authorization", "Bearer "+i.Token)
+		}),
+	}
+	if i.ProxyURL != "" {
+		opts = append(opts, withProxy(i.ProxyURL))
+	}
+	if i.TLSConfig != nil {
+		opts = append(opts, withTLSConfig(i.TLSConfig))
+	}
+
+	if err := postMessage(ctx, i.URL, toIncidentIOAlertEvent(event, GetLinks(ctx)), opts...); err != nil {
+		return fmt.Errorf("failed sending alert event: %w", err)
+	}
+
+	return nil
+}
+
+func toIncidentIOAlertEvent(event eventv1.Event, links []Link) IncidentIOAlertEvent {
+	name, desc := formatNameAndDescription(event)
+
+	metadata := make(map[string]string, len(event.Metadata)+4)
+	for k, v := range event.Metadata {
+		metadata[k] = v
+	}
+	metadata["kind"] = event.InvolvedObject.Kind
+	metadata["namespace"] = event.InvolvedObject.Namespace
+	metadata["name"] = event.InvolvedObject.Name
+	metadata["reportingController"] = event.ReportingController
+
+	// Resolve just in case an existing alert is firing
+	e := IncidentIOAlertEvent{
+		Title:            desc + ": " + name,
+		Description:      event.Message,
+		DeduplicationKey: involvedObjectID(event),
+		Status:           "resolved",
+		Metadata:         metadata,
+	}
+	// Fire an alert for errors
+	if event.Severity == eventv1.EventSeverityError {
+		e.Status = "firing"
+	}
+	if len(links) > 0 {
+		e.SourceURL = links[0].URL
+	}
+	return e
+}
@@ -0,0 +1,142 @@
+/*
+Copyright 2026 The Flux authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notifier
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+
+	eventv1 "github.com/fluxcd/pkg/apis/event/v1beta1"
+	"github.com/fluxcd/pkg/apis/meta"
+)
+
+func TestNewIncidentIO(t *testing.T) {
+	tests := []struct {
+		name        string
+		address     string
+		token       string
+		expectedErr string
+	}{
+		{
+			name:        "invalid address",
+			address:     "incident.io",
+			token:       "token",
+			expectedErr: "invalid incident.io alert source URL (address)",
+		},
+		{
+			name:        "token is required",
+			address:     "https://api.incident.io/v2/alert_events/http/01GW2G3V0S59R238FAHPDS1R66",
+			expectedErr: "incident.io alert source token cannot be empty",
+		},
+		{
+			name:    "valid",
+			address: "https://api.incident.io/v2/alert_events/http/01GW2G3V0S59R238FAHPDS1R66",
+			token:   "token",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			i, err := NewIncidentIO(tt.address, "", nil, tt.token)
+			if tt.expectedErr != "" {
+				g.Expect(err).To(MatchError(ContainSubstring(tt.expectedErr)))
+				g.Expect(i).To(BeNil())
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(i.URL).To(Equal(tt.address))
+		})
+	}
+}
+
+func TestIncidentIO_Post(t *testing.T) {
+	g := NewWithT(t)
+
+	var events []IncidentIOAlertEvent
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		g.Expect(r.Header.Get("Authorization")).To(Equal("Bearer alert-source-token"))
+		var e IncidentIOAlertEvent
+		g.Expect(json.NewDecoder(r.Body).Decode(&e)).To(Succeed())
+		events = append(events, e)
+		w.WriteHeader(http.StatusAccepted)
+		_, _ = w.Write([]byte(`{"status":"success","deduplication_key":"key"}`))
+	}))
+	defer ts.Close()
+
+	i, err := NewIncidentIO(ts.URL, "", nil, "alert-source-token")
+	g.Expect(err).ToNot(HaveOccurred())
+
+	event := testEvent()
+	event.Severity = eventv1.EventSeverityError
+	ctx := WithLinks(context.TODO(), []Link{{Name: "Dashboard", URL: "https://flux.example.com"}})
+	g.Expect(i.Post(ctx, event)).To(Succeed())
+
+	progressing := testEvent()
+	progressing.Reason = meta.ProgressingReason
+	g.Expect(i.Post(context.TODO(), progressing)).To(Succeed())
+
+	g.Expect(i.Post(context.TODO(), testEvent())).To(Succeed())
+
+	g.Expect(events).To(HaveLen(2))
+	g.Expect(events[0].Status).To(Equal("firing"))
+	g.Expect(events[0].SourceURL).To(Equal("https://flux.example.com"))
+	g.Expect(events[1].Status).To(Equal("resolved"))
+	g.Expect(events[1].DeduplicationKey).To(Equal(events[0].DeduplicationKey))
+}
+
+func TestToIncidentIOAlertEvent(t *testing.T) {
+	g := NewWithT(t)
+
+	event := eventv1.Event{
+		InvolvedObject: corev1.ObjectReference{
+			Kind:      "HelmRelease",
+			Namespace: "apps",
+			Name:      "podinfo",
+			UID:       "9b8a7c6d-5e4f-4a3b-9c2d-1e0f9a8b7c6d",
+		},
+		Severity:            eventv1.EventSeverityError,
+		Reason:              "UpgradeFailed",
+		Message:             "Helm upgrade failed",
+		Metadata:            map[string]string{"revision": "6.5.4"},
+		ReportingController: "helm-controller",
+	}
+
+	g.Expect(toIncidentIOAlertEvent(event, nil)).To(Equal(IncidentIOAlertEvent{
+		Title:            "upgrade failed: helmrelease/podinfo",
+		Description:      "Helm upgrade failed",
+		DeduplicationKey: "9b8a7c6d-5e4f-4a3b-9c2d-1e0f9a8b7c6d",
+		Status:           "firing",
+		Metadata: map[string]string{
+			"revision":            "6.5.4",
+			"kind":                "HelmRelease",
+			"namespace":           "apps",
+			"name":                "podinfo",
+			"reportingController": "helm-controller",
+		},
+	}))
+
+	event.Severity = eventv1.EventSeverityInfo
+	g.Expect(toIncidentIOAlertEvent(event, nil).Status).To(Equal("resolved"))
+}
@@ -0,0 +1,333 @@
+/*
+Copyright 2026 The Flux authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notifier
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/go-retryablehttp"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	eventv1 "github.com/fluxcd/pkg/apis/event/v1beta1"
+
+	apiv1beta3 "github.com/fluxcd/notification-controller/api/v1beta3"
+)
+
+const (
+	// jiraDefaultIssueType is the issue type used when .spec.jira.issueType is not set.
+	jiraDefaultIssueType = "Bug"
+
+	// jiraLabelPrefix is the prefix of the label identifying the issues of an involved object.
+	jiraLabelPrefix = "flux-"
+)
+
+// errJiraNotFound is returned by the search request when the endpoint is not available.
+var errJiraNotFound = errors.New("not found")
+
+// jiraIssueTypeIDRegex matches numeric issue type IDs.
+var jiraIssueTypeIDRegex = regexp.MustCompile(`^[0-9]+$`)
+
+// Jira holds the configuration for opening, commenting and closing Jira issues.
+type Jira struct {
+	URL          string
+	ProjectKey   string
+	IssueType    string
+	TransitionID string
+	Username     string
+	Password     string
+	Token        string
+	ProxyURL     string
+	TLSConfig    *tls.Config
+}
+
+type jiraIssueFields struct {
+	Project     jiraRef  `json:"project"`
+	IssueType   jiraRef  `json:"issuetype"`
+	Summary     string   `json:"summary"`
+	Description string   `json:"description"`
+	Labels      []string `json:"labels"`
+}
+
+type jiraRef struct {
+	ID   string `json:"id,omitempty"`
+	Key  string `json:"key,omitempty"`
+	Name string `json:"name,omitempty"`
+}
+
+type jiraIssue struct {
+	Key    string           `json:"key,omitempty"`
+	Fields *jiraIssueFields `json:"fields,omitempty"`
+}
+
+type jiraSearchRequest struct {
+	JQL        string   `json:"jql"`
+	Fields     []string `json:"fields"`
+	MaxResults int      `json:"maxResults"`
+}
+
+type jiraSearchResponse struct {
+	Issues []jiraIssue `json:"issues"`
+}
+
+type jiraComment struct {
+	Body string `json:"body"`
+}
+
+type jiraTransitionRequest struct {
+	Transition jiraRef    `json:"transition"`
+	Update     jiraUpdate `json:"update"`
+}
+
+type jiraUpdate struct {
+	Comment []jiraCommentOperation `json:"comment"`
+}
+
+type jiraCommentOperation struct {
+	Add jiraComment `json:"add"`
+}
+
+// NewJira creates a new Jira notifier.
+//
+// Parameters:
+//   - address: Jira base URL (e.g., "https://example.atlassian.net")
+//   - proxyURL: HTTP/S proxy URL (optional)
+//   - tlsConfig: TLS configuration (optional)
+//   - projectKey: the key of the project the issues are created in
+//   - username: Username for basic authentication, with the password or the token (optional)
+//   - password: Password or API token for basic authentication (optional)
+//   - token: API token for basic authentication, or personal access token when the username is empty
+//   - opts: the issue type name or ID (default "Bug") and the transition performed
+//     on recovery (optional)
+//
+// Returns an error if the address, the project key or the credentials are empty,
+// or if the password is set without the username.
+func NewJira(address string, proxyURL string, tlsConfig *tls.Config, projectKey string,
+	username string, password string, token string, opts *apiv1beta3.JiraOptions) (*Jira, error) {
+	if address == "" {
+		return nil, errors.New("Jira URL (address) cannot be empty")
+	}
+	u, err := url.ParseRequestURI(address)
+	if err != nil {
+		return nil, fmt.Errorf("invalid Jira URL (address) %q: %w", address, err)
+	}
+	if projectKey == "" {
+		return nil, errors.New("Jira project key (channel) cannot be empty")
+	}
+	if password == "" && token == "" {
+		return nil, errors.New("Jira password or token cannot be empty")
+	}
+	if password != "" && username == "" {
+		return nil, errors.New("Jira username cannot be empty when the password is set, use the token for personal access tokens")
+	}
+
+	j := &Jira{
+		URL:        strings.TrimSuffix(u.String(), "/"),
+		ProjectKey: projectKey,
+		IssueType:  jiraDefaultIssueType,
+		Username:   username,
+		Password:   password,
+		Token:      token,
+		ProxyURL:   proxyURL,
+		TLSConfig:  tlsConfig,
+	}
+	if opts != nil {
+		if val := strings.TrimSpace(opts.IssueType); val != "" {
+			j.IssueType = val
+		}
+		j.TransitionID = strings.TrimSpace(opts.TransitionID)
+	}
+
+	return j, nil
+}
+
+// Post creates an issue for a failing object, comments on the open issue of the object
+// for repeated failures, and transitions the open issue when the object recovers.
+func (j *Jira) Post(ctx context.Context, event eventv1.Event) error {
+	// skip the info events that don't signal a recovery, e.g. progressing events
+	if event.Severity != eventv1.EventSeverityError && !IsRecoveryEvent(&event) {
+		return nil
+	}
+
+	key, err := j.findOpenIssue(ctx, event)
+	if err != nil {
+		return fmt.Errorf("failed searching for open issue: %w", err)
+	}
+
+	switch {
+	case event.Severity == eventv1.EventSeverityError && key == "":
+		var issue jiraIssue
+		if err := postMessage(ctx, j.URL+"/rest/api/2/issue", j.newIssue(ctx, event),
+			j.postOptions(withResponseBody(&issue))...); err != nil {
+			return fmt.Errorf("failed creating issue: %w", err)
+		}
+		log.FromContext(ctx).V(1).Info("Jira issue created", "issue", issue.Key)
+	case event.Severity == eventv1.EventSeverityError:
+		if err := postMessage(ctx, j.issueURL(key)+"/comment", jiraComment{Body: jiraDescription(ctx, event)},
+			j.postOptions()...); err != nil {
+			return fmt.Errorf("failed commenting on issue %s: %w", key, err)
+		}
+		log.FromContext(ctx).V(1).Info("Jira issue commented", "issue", key)
+	case key != "" && IsRecoveryEvent(&event):
+		if err := j.resolveIssue(ctx, key, event); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// findOpenIssue returns the key of the latest unresolved issue of the involved object,
+// or an empty string if there is none.
+func (j *Jira) findOpenIssue(ctx context.Context, event eventv1.Event) (string, error) {
+	search := jiraSearchRequest{
+		JQL: fmt.Sprintf(`project = "%s" AND labels = "%s" AND statusCategory != Done ORDER BY created DESC`,
+			j.ProjectKey, jiraLabel(event)),
+		Fields:     []string{"key"},
+		MaxResults: 1,
+	}
+	notFound := withResponseValidator(func(resp *http.Response) error {
+		if resp.StatusCode == http.StatusNotFound {
+			return errJiraNotFound
+		}
+		return validateResponseStatus(resp)
+	})
+
+	// Jira Cloud replaced the search endpoint with search/jql,
+	// fall back to the former for Jira Server and Data Center.
+	var result jiraSearchResponse
+	err := postMessage(ctx, j.URL+"/rest/api/2/search/jql", search,
+		j.postOptions(notFound, withResponseBody(&result))...)
+	if errors.Is(err, errJiraNotFound) {
+		err = postMessage(ctx, j.URL+"/rest/api/2/search", search,
+			j.postOptions(withResponseBody(&result))...)
+	}
+	if err != nil {
+		return "", err
+	}
+
+	if len(result.Issues) == 0 {
+		return "", nil
+	}
+	return result.Issues[0].Key, nil
+}
+
+// resolveIssue comments on the issue, and performs the configured transition.
+func (j *Jira) resolveIssue(ctx context.Context, key string, event eventv1.Event) error {
+	comment := jiraComment{Body: jiraDescription(ctx, event)}
+	if j.TransitionID == "" {
+		if err := postMessage(ctx, j.issueURL(key)+"/comment", comment, j.postOptions()...); err != nil {
+			return fmt.Errorf("failed commenting on issue %s: %w", key, err)
+		}
+		log.FromContext(ctx).V(1).Info("Jira issue commented", "issue", key)
+		return nil
+	}
+
+	transition := jiraTransitionRequest{
+		Transition: jiraRef{ID: j.TransitionID},
+		Update:     jiraUpdate{Comment: []jiraCommentOperation{{Add: comment}}},
+	}
+	if err := postMessage(ctx, j.issueURL(key)+"/transitions", transition, j.postOptions()...); err != nil {
+		return fmt.Errorf("failed transitioning issue %s: %w", key, err)
+	}
+	log.FromContext(ctx).V(1).Info("Jira issue transitioned", "issue", key, "transition", j.TransitionID)
+
+	return nil
+}
+
+func (j *Jira) newIssue(ctx context.Context, event eventv1.Event) jiraIssue {
+	issueType := jiraRef{Name: j.IssueType}
+	if jiraIssueTypeIDRegex.MatchString(j.IssueType) {
+		issueType = jiraRef{ID: j.IssueType}
+	}
+
+	summary := fmt.Sprintf("%s/%s.%s: %s", event.InvolvedObject.Kind,
+		event.InvolvedObject.Name, event.InvolvedObject.Namespace, event.Reason)
+	if len(summary) > 255 {
+		summary = summary[:252] + "..."
+	}
+
+	return jiraIssue{
+		Fields: &jiraIssueFields{
+			Project:     jiraRef{Key: j.ProjectKey},
+			IssueType:   issueType,
+			Summary:     summary,
+			Description: jiraDescription(ctx, event),
+			Labels:      []string{"flux", jiraLabel(event)},
+		},
+	}
+}
+
+func (j *Jira) issueURL(key string) string {
+	return j.URL + "/rest/api/2/issue/" + url.PathEscape(key)
+}
+
+func (j *Jira) postOptions(opts ...postOption) []postOption {
+	var o []postOption
+	if j.ProxyURL != "" {
+		o = append(o, withProxy(j.ProxyURL))
+	}
+	if j.TLSConfig != nil {
+		o = append(o, withTLSConfig(j.TLSConfig))
+	}
+	switch {
+	case j.Username != "" && j.Password != "":
+		o = append(o, withBasicAuth(j.Username, j.Password))
+	case j.Username != "":
+		o = append(o, withBasicAuth(j.Username, j.Token))
+	default:
+		o = append(o, withRequestModifier(func(req *retryablehttp.Request) {
+			req.Header.Set("Authorization", "Bearer "+j.Token)
+		}))
+	}
+	return append(o, opts...)
+}
+
+// jiraLabel returns the label identifying the issues of the involved object.
+func jiraLabel(event eventv1.Event) string {
+	return jiraLabelPrefix + involvedObjectID(event)
+}
+
+// jiraDescription renders the event in the Jira wiki markup.
+func jiraDescription(ctx context.Context, event eventv1.Event) string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "*%s/%s.%s* (%s)\n\n", event.InvolvedObject.Kind, event.InvolvedObject.Name,
+		event.InvolvedObject.Namespace, event.Severity)
+	fmt.Fprintf(&sb, "{noformat}\n%s\n{noformat}\n", event.Message)
+
+	keys := make([]string, 0, len(event.Metadata))
+	for k := range event.Metadata {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	for _, k := range keys {
+		fmt.Fprintf(&sb, "\n*%s*: %s", k, event.Metadata[k])
+	}
+
+	for _, link := range GetLinks(ctx) {
+		fmt.Fprintf(&sb, "\n[%s|%s]", link.Name, link.URL)
+	}
+
+	return sb.String()
+}
@@ -0,0 +1,314 @@
+/*
+Copyright 2026 The Flux authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notifier
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+
+	eventv1 "github.com/fluxcd/pkg/apis/event/v1beta1"
+	"github.com/fluxcd/pkg/apis/meta"
+
+	apiv1beta3 "github.com/fluxcd/notification-controller/api/v1beta3"
+)
+
+func TestNewJira(t *testing.T) {
+	tests := []struct {
+		name              string
+		address           string
+		projectKey        string
+		username          string
+		password          string
+		token             string
+		opts              *apiv1beta3.JiraOptions
+		expectedErr       string
+		expectedIssueType string
+	}{
+		{
+			name:        "empty address is not allowed",
+			expectedErr: "Jira URL (address) cannot be empty",
+		},
+		{
+			name:        "invalid address",
+			address:     "example.atlassian.net",
+			expectedErr: "invalid Jira URL (address)",
+		},
+		{
+			name:        "empty project key is not allowed",
+			address:     "https://example.atlassian.net",
+			token:       "token",
+			expectedErr: "Jira project key (channel) cannot be empty",
+		},
+		{
+			name:        "empty credentials are not allowed",
+			address:     "https://example.atlassian.net",
+			projectKey:  "OPS",
+			expectedErr: "Jira password or token cannot be empty",
+		},
+		{
+			name:        "password without username is not allowed",
+			address:     "https://example.atlassian.net",
+			projectKey:  "OPS",
+			password:    "pass",
+			expectedErr: "Jira username cannot be empty when the password is set",
+		},
+		{
+			name:              "default issue type",
+			address:           "https://example.atlassian.net/",
+			projectKey:        "OPS",
+			token:             "token",
+			expectedIssueType: "Bug",
+		},
+		{
+			name:              "custom issue type",
+			address:           "https://jira.example.com",
+			projectKey:        "OPS",
+			username:          "user",
+			password:          "pass",
+			opts:              &apiv1beta3.JiraOptions{IssueType: "Incident", TransitionID: "31"},
+			expectedIssueType: "Incident",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			j, err := NewJira(tt.address, "", nil, tt.projectKey, tt.username, tt.password, tt.token, tt.opts)
+			if tt.expectedErr != "" {
+				g.Expect(err).To(MatchError(ContainSubstring(tt.expectedErr)))
+				g.Expect(j).To(BeNil())
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(j.URL).ToNot(HaveSuffix("/"))
+			g.Expect(j.IssueType).To(Equal(tt.expectedIssueType))
+			if tt.opts != nil {
+				g.Expect(j.TransitionID).To(Equal(tt.opts.TransitionID))
+			}
+		})
+	}
+}
+
+// jiraStub is a minimal Jira REST API recording the requests it receives.
+type jiraStub struct {
+	openIssue   string
+	legacy      bool
+	jql         string
+	created     *jiraIssue
+	comments    []string
+	transitions []jiraTransitionRequest
+}
+
+func (s *jiraStub) handler(g *WithT) http.Handler {
+	mux := http.NewServeMux()
+	search := func(w http.ResponseWriter, r *http.Request) {
+		var req jiraSearchRequest
+		g.Expect(json.NewDecoder(r.Body).Decode(&req)).To(Succeed())
+		s.jql = req.JQL
+		resp := jiraSearchResponse{Issues: []jiraIssue{}}
+		if s.openIssue != "" {
+			resp.Issues = append(resp.Issues, jiraIssue{Key: s.openIssue})
+		}
+		json.NewEncoder(w).Encode(resp)
+	}
+	mux.HandleFunc("POST /rest/api/2/search/jql", func(w http.ResponseWriter, r *http.Request) {
+		if s.legacy {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		search(w, r)
+	})
+	mux.HandleFunc("POST /rest/api/2/search", search)
+	mux.HandleFunc("POST /rest/api/2/issue", func(w http.ResponseWriter, r *http.Request) {
+		s.created = &jiraIssue{}
+		g.Expect(json.NewDecoder(r.Body).Decode(s.created)).To(Succeed())
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"id":"10001","key":"OPS-1"}`))
+	})
+	mux.HandleFunc("POST /rest/api/2/issue/{key}/comment", func(w http.ResponseWriter, r *http.Request) {
+		g.Expect(r.PathValue("key")).To(Equal(s.openIssue))
+		var comment jiraComment
+		g.Expect(json.NewDecoder(r.Body).Decode(&comment)).To(Succeed())
+		s.comments = append(s.comments, comment.Body)
+		w.WriteHeader(http.StatusCreated)
+	})
+	mux.HandleFunc("POST /rest/api/2/issue/{key}/transitions", func(w http.ResponseWriter, r *http.Request) {
+		g.Expect(r.PathValue("key")).To(Equal(s.openIssue))
+		var transition jiraTransitionRequest
+		g.Expect(json.NewDecoder(r.Body).Decode(&transition)).To(Succeed())
+		s.transitions = append(s.transitions, transition)
+		w.WriteHeader(http.StatusNoContent)
+	})
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		user, pass, ok := r.BasicAuth()
+		g.Expect(ok).To(BeTrue())
+		g.Expect(user).To(Equal("flux@example.com"))
+		g.Expect(pass).To(Equal("api-token"))
+		mux.ServeHTTP(w, r)
+	})
+}
+
+func TestJira_Post(t *testing.T) {
+	event := eventv1.Event{
+		InvolvedObject: corev1.ObjectReference{
+			Kind:      "Kustomization",
+			Namespace: "flux-system",
+			Name:      "apps",
+			UID:       "8a5c9b1e-2d4f-4e6a-9b7c-1d2e3f4a5b6c",
+		},
+		Severity: eventv1.EventSeverityError,
+		Reason:   "HealthCheckFailed",
+		Message:  "timeout waiting for: [Deployment/apps/podinfo status: 'InProgress']",
+		Metadata: map[string]string{"revision": "main@sha1:abc"},
+	}
+	recovered := event
+	recovered.Severity = eventv1.EventSeverityInfo
+	recovered.Reason = meta.ReconciliationSucceededReason
+	recovered.Message = "Applied revision: main@sha1:def"
+	progressing := event
+	progressing.Severity = eventv1.EventSeverityInfo
+	progressing.Reason = meta.ProgressingReason
+	dependencyNotReady := event
+	dependencyNotReady.Severity = eventv1.EventSeverityInfo
+	dependencyNotReady.Reason = meta.DependencyNotReadyReason
+
+	tests := []struct {
+		name                string
+		event               eventv1.Event
+		openIssue           string
+		legacy              bool
+		transitionID        string
+		expectedCreated     bool
+		expectedComments    int
+		expectedTransitions int
+	}{
+		{
+			name:            "failure without open issue creates an issue",
+			event:           event,
+			expectedCreated: true,
+		},
+		{
+			name:            "search falls back to the Jira Server endpoint",
+			event:           event,
+			legacy:          true,
+			expectedCreated: true,
+		},
+		{
+			name:             "repeated failure comments on the open issue",
+			event:            event,
+			openIssue:        "OPS-1",
+			expectedComments: 1,
+		},
+		{
+			name:                "recovery transitions the open issue",
+			event:               recovered,
+			openIssue:           "OPS-1",
+			transitionID:        "31",
+			expectedTransitions: 1,
+		},
+		{
+			name:             "recovery without transition comments on the open issue",
+			event:            recovered,
+			openIssue:        "OPS-1",
+			expectedComments: 1,
+		},
+		{
+			name:  "recovery without open issue is a no-op",
+			event: recovered,
+		},
+		{
+			name:      "progressing events are skipped",
+			event:     progressing,
+			openIssue: "OPS-1",
+		},
+		{
+			name:         "info events other than recoveries leave the open issue",
+			event:        dependencyNotReady,
+			openIssue:    "OPS-1",
+			transitionID: "31",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			stub := &jiraStub{openIssue: tt.openIssue, legacy: tt.legacy}
+			ts := httptest.NewServer(stub.handler(g))
+			defer ts.Close()
+
+			j, err := NewJira(ts.URL, "", nil, "OPS", "flux@example.com", "api-token", "",
+				&apiv1beta3.JiraOptions{TransitionID: tt.transitionID})
+			g.Expect(err).ToNot(HaveOccurred())
+
+			ctx := WithLinks(context.TODO(), []Link{{Name: "Logs", URL: "https://logs.example.com"}})
+			g.Expect(j.Post(ctx, tt.event)).To(Succeed())
+
+			if tt.event.Severity == eventv1.EventSeverityError || IsRecoveryEvent(&tt.event) {
+				g.Expect(stub.jql).To(Equal(`project = "OPS" AND labels = "flux-8a5c9b1e-2d4f-4e6a-9b7c-1d2e3f4a5b6c" AND statusCategory != Done ORDER BY created DESC`))
+			}
+
+			g.Expect(stub.created != nil).To(Equal(tt.expectedCreated))
+			if tt.expectedCreated {
+				fields := stub.created.Fields
+				g.Expect(fields.Project.Key).To(Equal("OPS"))
+				g.Expect(fields.IssueType.Name).To(Equal("Bug"))
+				g.Expect(fields.Summary).To(Equal("Kustomization/apps.flux-system: HealthCheckFailed"))
+				g.Expect(fields.Labels).To(ConsistOf("flux", "flux-8a5c9b1e-2d4f-4e6a-9b7c-1d2e3f4a5b6c"))
+				g.Expect(fields.Description).To(ContainSubstring(event.Message))
+				g.Expect(fields.Description).To(ContainSubstring("*revision*: main@sha1:abc"))
+				g.Expect(fields.Description).To(ContainSubstring("[Logs|https://logs.example.com]"))
+			}
+
+			g.Expect(stub.comments).To(HaveLen(tt.expectedComments))
+			for _, comment := range stub.comments {
+				g.Expect(comment).To(ContainSubstring(tt.event.Message))
+			}
+
+			g.Expect(stub.transitions).To(HaveLen(tt.expectedTransitions))
+			for _, transition := range stub.transitions {
+				g.Expect(transition.Transition.ID).To(Equal(tt.transitionID))
+				g.Expect(transition.Update.Comment).To(HaveLen(1))
+				g.Expect(transition.Update.Comment[0].Add.Body).To(ContainSubstring(recovered.Message))
+			}
+		})
+	}
+}
+
+func TestJira_PostBearerToken(t *testing.T) {
+	g := NewWithT(t)
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		g.Expect(r.Header.Get("Authorization")).To(Equal("Bearer pat"))
+		w.Write([]byte(`{"issues":[]}`))
+	}))
+	defer ts.Close()
+
+	j, err := NewJira(ts.URL, "", nil, "OPS", "", "", "pat", nil)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	event := testEvent()
+	event.Reason = meta.ReconciliationSucceededReason
+	g.Expect(j.Post(context.TODO(), event)).To(Succeed())
+}
@@ -0,0 +1,171 @@
+/*
+Copyright 2026 The Flux authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notifier
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	eventv1 "github.com/fluxcd/pkg/apis/event/v1beta1"
+	"github.com/twmb/franz-go/pkg/kgo"
+	"github.com/twmb/franz-go/pkg/sasl"
+	"github.com/twmb/franz-go/pkg/sasl/plain"
+	"github.com/twmb/franz-go/pkg/sasl/scram"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	apiv1beta3 "github.com/fluxcd/notification-controller/api/v1beta3"
+)
+
+const (
+	kafkaSASLPlain       = "PLAIN"
+	kafkaSASLSCRAMSHA256 = "SCRAM-SHA-256"
+	kafkaSASLSCRAMSHA512 = "SCRAM-SHA-512"
+)
+
+type (
+	// Kafka holds a Kafka client and target topic.
+	Kafka struct {
+		topic  string
+		client interface {
+			produce(ctx context.Context, topic string, key, value []byte, headers map[string]string) error
+		}
+	}
+
+	kafkaClient struct {
+		brokers   []string
+		sasl      sasl.Mechanism
+		tlsConfig *tls.Config
+	}
+)
+
+// NewKafka creates a new Kafka notifier.
+//
+// Parameters:
+//   - brokers: comma-separated list of Kafka brokers (e.g., "kafka-0:9092,kafka-1:9092")
+//   - topic: Kafka topic to publish events to
+//   - username: Username for SASL authentication (optional)
+//   - password: Password for SASL authentication (optional)
+//   - tlsConfig: TLS configuration for the broker connections, enables TLS when set (optional)
+//   - opts: the SASL mechanism, one of PLAIN (default), SCRAM-SHA-256 and SCRAM-SHA-512,
+//     and whether TLS is enabled with the system CAs (optional)
+//
+// Returns an error if brokers or topic is empty.
+func NewKafka(brokers string, topic string, username string, password string,
+	tlsConfig *tls.Config, opts *apiv1beta3.KafkaOptions) (*Kafka, error) {
+	if topic == "" {
+		return nil, errors.New("Kafka topic (channel) cannot be empty")
+	}
+
+	client := &kafkaClient{tlsConfig: tlsConfig}
+	for _, b := range strings.Split(brokers, ",") {
+		if b = strings.TrimSpace(b); b != "" {
+			client.brokers = append(client.brokers, b)
+		}
+	}
+	if len(client.brokers) == 0 {
+		return nil, errors.New("Kafka brokers (address) cannot be empty")
+	}
+
+	if client.tlsConfig == nil && opts != nil && opts.TLS {
+		client.tlsConfig = &tls.Config{}
+	}
+
+	if username != "" || password != "" {
+		mechanism := kafkaSASLPlain
+		if opts != nil && opts.SASLMechanism != "" {
+			mechanism = strings.ToUpper(strings.TrimSpace(opts.SASLMechanism))
+		}
+		switch mechanism {
+		case kafkaSASLPlain:
+			client.sasl = plain.Auth{User: username, Pass: password}.AsMechanism()
+		case kafkaSASLSCRAMSHA256:
+			client.sasl = scram.Auth{User: username, Pass: password}.AsSha256Mechanism()
+		case kafkaSASLSCRAMSHA512:
+			client.sasl = scram.Auth{User: username, Pass: password}.AsSha512Mechanism()
+		default:
+			return nil, fmt.Errorf("unsupported SASL mechanism '%s', must be one of: %s, %s, %s",
+				mechanism, kafkaSASLPlain, kafkaSASLSCRAMSHA256, kafkaSASLSCRAMSHA512)
+		}
+	}
+
+	return &Kafka{
+		topic:  topic,
+		client: client,
+	}, nil
+}
+
+// Post publishes Flux events to a Kafka topic. The message key is derived from
+// the involved object, so the events of an object are published to the same
+// partition and stay ordered.
+func (k *Kafka) Post(ctx context.Context, event eventv1.Event) error {
+	eventPayload, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("error json-marshaling event: %w", err)
+	}
+
+	if err := k.client.produce(ctx, k.topic, kafkaKey(event), eventPayload, nil); err != nil {
+		return fmt.Errorf("error publishing event to topic %s: %w", k.topic, err)
+	}
+
+	// debug log
+	log.FromContext(ctx).V(1).Info("Event published to Kafka topic", "topic", k.topic)
+
+	return nil
+}
+
+// kafkaKey returns the message key of the event, derived from the involved object.
+func kafkaKey(event eventv1.Event) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%s", event.InvolvedObject.Kind,
+		event.InvolvedObject.Namespace, event.InvolvedObject.Name))
+}
+
+func (k *kafkaClient) produce(ctx context.Context, topic string, key, value []byte, headers map[string]string) error {
+	opts := []kgo.Opt{
+		kgo.SeedBrokers(k.brokers...),
+		kgo.ClientID("notification-controller"),
+	}
+	if k.tlsConfig != nil {
+		opts = append(opts, kgo.DialTLSConfig(k.tlsConfig))
+	}
+	if k.sasl != nil {
+		opts = append(opts, kgo.SASL(k.sasl))
+	}
+
+	cl, err := kgo.NewClient(opts...)
+	if err != nil {
+		return fmt.Errorf("error creating client: %w", err)
+	}
+	defer cl.Close()
+
+	record := &kgo.Record{
+		Topic: topic,
+		Key:   key,
+		Value: value,
+	}
+	for name, val := range headers {
+		record.Headers = append(record.Headers, kgo.RecordHeader{Key: name, Value: []byte(val)})
+	}
+	if err := cl.ProduceSync(ctx, record).FirstErr(); err != nil {
+		return fmt.Errorf("error producing message: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,173 @@
+/*
+Copyright 2026 The Flux authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notifier
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+
+	eventv1 "github.com/fluxcd/pkg/apis/event/v1beta1"
+
+	apiv1beta3 "github.com/fluxcd/notification-controller/api/v1beta3"
+)
+
+func TestNewKafka(t *testing.T) {
+	tests := []struct {
+		name              string
+		brokers           string
+		topic             string
+		username          string
+		password          string
+		tlsConfig         *tls.Config
+		opts              *apiv1beta3.KafkaOptions
+		expectedErr       string
+		expectedBrokers   []string
+		expectedMechanism string
+		expectedTLS       bool
+	}{
+		{
+			name:        "empty topic is not allowed",
+			brokers:     "kafka:9092",
+			expectedErr: "Kafka topic (channel) cannot be empty",
+		},
+		{
+			name:        "empty brokers are not allowed",
+			brokers:     " , ",
+			topic:       "flux",
+			expectedErr: "Kafka brokers (address) cannot be empty",
+		},
+		{
+			name:            "multiple brokers without auth",
+			brokers:         "kafka-0:9092, kafka-1:9092",
+			topic:           "flux",
+			expectedBrokers: []string{"kafka-0:9092", "kafka-1:9092"},
+		},
+		{
+			name:              "SASL PLAIN by default",
+			brokers:           "kafka:9092",
+			topic:             "flux",
+			username:          "user",
+			password:          "pass",
+			expectedBrokers:   []string{"kafka:9092"},
+			expectedMechanism: "PLAIN",
+		},
+		{
+			name:              "SASL SCRAM with TLS",
+			brokers:           "kafka:9093",
+			topic:             "flux",
+			username:          "user",
+			password:          "pass",
+			opts:              &apiv1beta3.KafkaOptions{SASLMechanism: "SCRAM-SHA-512", TLS: true},
+			expectedBrokers:   []string{"kafka:9093"},
+			expectedMechanism: "SCRAM-SHA-512",
+			expectedTLS:       true,
+		},
+		{
+			name:            "mTLS",
+			brokers:         "kafka:9093",
+			topic:           "flux",
+			tlsConfig:       &tls.Config{},
+			expectedBrokers: []string{"kafka:9093"},
+			expectedTLS:     true,
+		},
+		{
+			name:        "unsupported SASL mechanism",
+			brokers:     "kafka:9092",
+			topic:       "flux",
+			username:    "user",
+			password:    "pass",
+			opts:        &apiv1beta3.KafkaOptions{SASLMechanism: "GSSAPI"},
+			expectedErr: "unsupported SASL mechanism 'GSSAPI'",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			provider, err := NewKafka(tt.brokers, tt.topic, tt.username, tt.password, tt.tlsConfig, tt.opts)
+			if tt.expectedErr != "" {
+				g.Expect(err).To(MatchError(ContainSubstring(tt.expectedErr)))
+				g.Expect(provider).To(BeNil())
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(provider.topic).To(Equal(tt.topic))
+
+			client := provider.client.(*kafkaClient)
+			g.Expect(client.brokers).To(Equal(tt.expectedBrokers))
+			g.Expect(client.tlsConfig != nil).To(Equal(tt.expectedTLS))
+			if tt.expectedMechanism == "" {
+				g.Expect(client.sasl).To(BeNil())
+			} else {
+				g.Expect(client.sasl.Name()).To(Equal(tt.expectedMechanism))
+			}
+		})
+	}
+}
+
+type fakeKafkaClient struct {
+	topic      string
+	key        string
+	value      string
+	headers    map[string]string
+	produceErr error
+}
+
+func (f *fakeKafkaClient) produce(ctx context.Context, topic string, key, value []byte, headers map[string]string) error {
+	f.topic = topic
+	f.key = string(key)
+	f.value = string(value)
+	f.headers = headers
+	return f.produceErr
+}
+
+func TestKafkaPost(t *testing.T) {
+	event := eventv1.Event{
+		InvolvedObject: corev1.ObjectReference{
+			Kind:      "Kustomization",
+			Namespace: "flux-system",
+			Name:      "apps",
+		},
+		Metadata: map[string]string{"foo": "bar"},
+	}
+
+	t.Run("events are keyed by involved object", func(t *testing.T) {
+		g := NewWithT(t)
+		client := &fakeKafkaClient{}
+		k := &Kafka{topic: "flux", client: client}
+
+		g.Expect(k.Post(context.TODO(), event)).To(Succeed())
+		g.Expect(client.topic).To(Equal("flux"))
+		g.Expect(client.key).To(Equal("Kustomization/flux-system/apps"))
+		g.Expect(client.value).To(Equal(`{"involvedObject":{"kind":"Kustomization","namespace":"flux-system","name":"apps"},"severity":"","timestamp":null,"message":"","reason":"","metadata":{"foo":"bar"},"reportingController":""}`))
+	})
+
+	t.Run("produce error is wrapped and relayed", func(t *testing.T) {
+		g := NewWithT(t)
+		client := &fakeKafkaClient{produceErr: errors.New("broker unavailable")}
+		k := &Kafka{topic: "flux", client: client}
+
+		err := k.Post(context.TODO(), event)
+		g.Expect(err).To(MatchError("error publishing event to topic flux: broker unavailable"))
+	})
+}
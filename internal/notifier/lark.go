@@ -2,20 +2,28 @@ package notifier
 
 import (
 	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
 	"fmt"
 	"net/url"
+	"strconv"
 	"strings"
+	"time"
 
 	eventv1 "github.com/fluxcd/pkg/apis/event/v1beta1"
 )
 
 type Lark struct {
-	URL string
+	URL           string
+	SigningSecret string
 }
 
 type LarkPayload struct {
-	MsgType string   `json:"msg_type"`
-	Card    LarkCard `json:"card"`
+	Timestamp string   `json:"timestamp,omitempty"`
+	Sign      string   `json:"sign,omitempty"`
+	MsgType   string   `json:"msg_type"`
+	Card      LarkCard `json:"card"`
 }
 
 type LarkCard struct {
@@ -50,14 +58,19 @@ type LarkText struct {
 	Content string `json:"content"`
 }
 
-func NewLark(address string) (*Lark, error) {
+// NewLark validates the Lark URL and returns a Lark object. The
+// "signingSecret" key of the secret data sets the secret used to sign
+// the requests, required when the bot has the signature verification
+// security setting.
+func NewLark(address string, secretData map[string][]byte) (*Lark, error) {
 	_, err := url.ParseRequestURI(address)
 	if err != nil {
 		return nil, fmt.Errorf("invalid Slack hook URL %s", address)
 	}
 
 	return &Lark{
-		URL: address,
+		URL:           address,
+		SigningSecret: strings.TrimSpace(string(secretData["signingSecret"])),
 	}, nil
 }
 
@@ -104,5 +117,18 @@ func (l *Lark) Post(ctx context.Context, event eventv1.Event) error {
 		Card:    card,
 	}
 
-	return postMessage(ctx, l.URL, payload)
+	if l.SigningSecret != "" {
+		timestamp := time.Now().Unix()
+		payload.Timestamp = strconv.FormatInt(timestamp, 10)
+		payload.Sign = larkSign(l.SigningSecret, timestamp)
+	}
+
+	return postMessage(ctx, l.URL, payload, withResponseValidator(validateChatBotResponse))
+}
+
+// larkSign returns the signature of the timestamp in seconds, the
+// HMAC-SHA256 of an empty message keyed with "timestamp\nsecret".
+func larkSign(secret string, timestamp int64) string {
+	mac := hmac.New(sha256.New, []byte(fmt.Sprintf("%d\n%s", timestamp, secret)))
+	return base64.StdEncoding.EncodeToString(mac.Sum(nil))
 }
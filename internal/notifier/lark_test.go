@@ -6,6 +6,7 @@ import (
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"strconv"
 	"testing"
 
 	. "github.com/onsi/gomega"
@@ -25,9 +26,40 @@ func TestLark_Post(t *testing.T) {
 	}))
 	defer ts.Close()
 
-	lark, err := NewLark(ts.URL)
+	lark, err := NewLark(ts.URL, nil)
 	g.Expect(err).ToNot(HaveOccurred())
 
 	err = lark.Post(context.TODO(), testEvent())
 	g.Expect(err).ToNot(HaveOccurred())
 }
+
+func TestLark_PostSigned(t *testing.T) {
+	g := NewWithT(t)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var payload LarkPayload
+		g.Expect(json.NewDecoder(r.Body).Decode(&payload)).To(Succeed())
+
+		timestamp, err := strconv.ParseInt(payload.Timestamp, 10, 64)
+		g.Expect(err).ToNot(HaveOccurred())
+		if payload.Sign != larkSign("secret", timestamp) {
+			_, _ = w.Write([]byte(`{"code":19021,"msg":"sign match fail or timestamp is not within one hour from current time"}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"code":0,"msg":"success","data":{}}`))
+	}))
+	defer ts.Close()
+
+	lark, err := NewLark(ts.URL, map[string][]byte{"signingSecret": []byte("secret")})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(lark.Post(context.TODO(), testEvent())).To(Succeed())
+
+	lark, err = NewLark(ts.URL, map[string][]byte{"signingSecret": []byte("wrong")})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(lark.Post(context.TODO(), testEvent())).To(MatchError(ContainSubstring("webhook responded with error code 19021")))
+}
+
+func TestLark_Sign(t *testing.T) {
+	g := NewWithT(t)
+	// HMAC-SHA256 of an empty message keyed with "1792324800\nSEC0123456789".
+	g.Expect(larkSign("SEC0123456789", 1792324800)).To(Equal("dYS1d6D8VXiv8Vhucb0UZROEibZSkeUj6Flks6mdmjg="))
+}
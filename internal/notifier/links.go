@@ -0,0 +1,127 @@
+/*
+Copyright 2026 The Flux authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notifier
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"text/template"
+
+	eventv1 "github.com/fluxcd/pkg/apis/event/v1beta1"
+
+	apiv1beta3 "github.com/fluxcd/notification-controller/api/v1beta3"
+)
+
+// Link is a rendered link-back URL.
+type Link struct {
+	Name string
+	URL  string
+}
+
+type linksContextKey struct{}
+
+// WithLinks returns a context carrying the links rendered for the event.
+func WithLinks(ctx context.Context, links []Link) context.Context {
+	return context.WithValue(ctx, linksContextKey{}, links)
+}
+
+// GetLinks returns the links rendered for the event, if any.
+func GetLinks(ctx context.Context) []Link {
+	links, _ := ctx.Value(linksContextKey{}).([]Link)
+	return links
+}
+
+var linkFuncs = template.FuncMap{
+	"commitURL": commitURL,
+}
+
+// RenderLinks renders the link templates with the given event. Links rendering
+// to an empty string are omitted. The links that failed to render, or rendered
+// to a URL whose scheme is not http or https, are omitted and their errors are
+// returned along with the rendered links.
+func RenderLinks(templates []apiv1beta3.Link, event *eventv1.Event) ([]Link, error) {
+	var links []Link
+	var errs []error
+	for _, t := range templates {
+		tmpl, err := template.New(t.Name).Funcs(linkFuncs).Option("missingkey=zero").Parse(t.URL)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to parse link '%s': %w", t.Name, err))
+			continue
+		}
+		var sb strings.Builder
+		if err := tmpl.Execute(&sb, event); err != nil {
+			errs = append(errs, fmt.Errorf("failed to render link '%s': %w", t.Name, err))
+			continue
+		}
+		u := strings.TrimSpace(sb.String())
+		if u == "" {
+			continue
+		}
+		parsed, err := url.ParseRequestURI(u)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("invalid URL rendered for link '%s': %w", t.Name, err))
+			continue
+		}
+		if parsed.Scheme != "http" && parsed.Scheme != "https" {
+			errs = append(errs, fmt.Errorf("invalid URL rendered for link '%s': scheme must be http or https", t.Name))
+			continue
+		}
+		links = append(links, Link{Name: t.Name, URL: u})
+	}
+	return links, errors.Join(errs...)
+}
+
+// commitURL returns the web URL of the commit of the given revision
+// in the Git repository at the given address.
+func commitURL(address, revision string) (string, error) {
+	host, id, err := parseGitAddress(address)
+	if err != nil {
+		return "", err
+	}
+	sha, err := parseRevision(revision)
+	if err != nil {
+		return "", err
+	}
+
+	u, err := url.Parse(host)
+	if err != nil {
+		return "", err
+	}
+	switch {
+	case strings.Contains(u.Hostname(), "gitlab"):
+		return fmt.Sprintf("%s/%s/-/commit/%s", host, id, sha), nil
+	case u.Hostname() == "bitbucket.org":
+		return fmt.Sprintf("%s/%s/commits/%s", host, id, sha), nil
+	default:
+		return fmt.Sprintf("%s/%s/commit/%s", host, id, sha), nil
+	}
+}
+
+// markdownLinks formats the links as Markdown to be appended to a message.
+func markdownLinks(links []Link) string {
+	if len(links) == 0 {
+		return ""
+	}
+	formatted := make([]string, 0, len(links))
+	for _, l := range links {
+		formatted = append(formatted, fmt.Sprintf("[%s](%s)", l.Name, l.URL))
+	}
+	return "\n\n" + strings.Join(formatted, " | ")
+}
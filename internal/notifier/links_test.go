@@ -0,0 +1,135 @@
+/*
+Copyright 2026 The Flux authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notifier
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	apiv1beta3 "github.com/fluxcd/notification-controller/api/v1beta3"
+)
+
+func TestRenderLinks(t *testing.T) {
+	event := testEvent()
+	event.Metadata["revision"] = "main@sha1:9f7c4b0e3a0dce3bcb0a6fe2b2b7c1bd4a0a3c4f"
+
+	tests := []struct {
+		name      string
+		templates []apiv1beta3.Link
+		want      []Link
+		wantErr   bool
+	}{
+		{
+			name: "object fields",
+			templates: []apiv1beta3.Link{
+				{Name: "Dashboard", URL: "https://dashboard.example.com/{{ .InvolvedObject.Kind }}/{{ .InvolvedObject.Namespace }}/{{ .InvolvedObject.Name }}"},
+			},
+			want: []Link{
+				{Name: "Dashboard", URL: "https://dashboard.example.com/GitRepository/gitops-system/webapp"},
+			},
+		},
+		{
+			name: "commit URL",
+			templates: []apiv1beta3.Link{
+				{Name: "Commit", URL: `{{ commitURL "ssh://git@github.com/org/repo.git" .Metadata.revision }}`},
+			},
+			want: []Link{
+				{Name: "Commit", URL: "https://github.com/org/repo/commit/9f7c4b0e3a0dce3bcb0a6fe2b2b7c1bd4a0a3c4f"},
+			},
+		},
+		{
+			name: "empty links are omitted",
+			templates: []apiv1beta3.Link{
+				{Name: "Empty", URL: "{{ .Metadata.missing }}"},
+			},
+		},
+		{
+			name: "failed links are omitted",
+			templates: []apiv1beta3.Link{
+				{Name: "Commit", URL: `{{ commitURL "https://github.com/org/repo" .Metadata.missing }}`},
+				{Name: "Invalid", URL: "{{ .Invalid"},
+				{Name: "Docs", URL: "https://docs.example.com"},
+			},
+			want: []Link{
+				{Name: "Docs", URL: "https://docs.example.com"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "links with other schemes are omitted",
+			templates: []apiv1beta3.Link{
+				{Name: "Script", URL: "javascript:alert(document.cookie)"},
+				{Name: "File", URL: "file:///etc/passwd"},
+				{Name: "Data", URL: "data:text/html;base64,PHNjcmlwdD4="},
+				{Name: "Runbook", URL: "http://runbooks.example.com/{{ .Reason }}"},
+			},
+			want: []Link{
+				{Name: "Runbook", URL: "http://runbooks.example.com/reason"},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			links, err := RenderLinks(tt.templates, &event)
+			if tt.wantErr {
+				g.Expect(err).To(HaveOccurred())
+			} else {
+				g.Expect(err).ToNot(HaveOccurred())
+			}
+			g.Expect(links).To(Equal(tt.want))
+		})
+	}
+}
+
+func TestCommitURL(t *testing.T) {
+	const revision = "main@sha1:9f7c4b0e3a0dce3bcb0a6fe2b2b7c1bd4a0a3c4f"
+	const sha = "9f7c4b0e3a0dce3bcb0a6fe2b2b7c1bd4a0a3c4f"
+
+	tests := []struct {
+		address string
+		want    string
+	}{
+		{address: "https://github.com/org/repo", want: "https://github.com/org/repo/commit/" + sha},
+		{address: "https://gitlab.com/group/sub/repo.git", want: "https://gitlab.com/group/sub/repo/-/commit/" + sha},
+		{address: "ssh://git@bitbucket.org/org/repo.git", want: "https://bitbucket.org/org/repo/commits/" + sha},
+		{address: "https://gitea.example.com/org/repo", want: "https://gitea.example.com/org/repo/commit/" + sha},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.address, func(t *testing.T) {
+			g := NewWithT(t)
+			u, err := commitURL(tt.address, revision)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(u).To(Equal(tt.want))
+		})
+	}
+}
+
+func TestGetLinks(t *testing.T) {
+	g := NewWithT(t)
+
+	g.Expect(GetLinks(context.TODO())).To(BeEmpty())
+
+	links := []Link{{Name: "Docs", URL: "https://docs.example.com"}}
+	g.Expect(GetLinks(WithLinks(context.TODO(), links))).To(Equal(links))
+	g.Expect(markdownLinks(links)).To(Equal("\n\n[Docs](https://docs.example.com)"))
+}
@@ -0,0 +1,218 @@
+/*
+Copyright 2026 The Flux authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notifier
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	eventv1 "github.com/fluxcd/pkg/apis/event/v1beta1"
+	"github.com/hashicorp/go-retryablehttp"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	apiv1beta3 "github.com/fluxcd/notification-controller/api/v1beta3"
+)
+
+// lokiPushPath is the path of the Loki push API.
+const lokiPushPath = "/loki/api/v1/push"
+
+// Loki holds the push API URL, the tenant and the credentials of a Loki instance.
+type Loki struct {
+	URL            string
+	ProxyURL       string
+	TLSConfig      *tls.Config
+	TenantID       string
+	Username       string
+	Password       string
+	Token          string
+	MetadataLabels []string
+	batchKey       string
+	batch          batchOptions
+}
+
+// lokiStream is a stream of the Loki push API, the values are pairs of
+// timestamps in nanoseconds and log lines.
+type lokiStream struct {
+	Stream map[string]string `json:"stream"`
+	Values [][2]string       `json:"values"`
+}
+
+type lokiPushRequest struct {
+	Streams []lokiStream `json:"streams"`
+}
+
+// NewLoki creates a new Grafana Loki notifier.
+//
+// Parameters:
+//   - providerUID: the UID of the Provider, the events of a Provider are sent in batches
+//   - address: Loki URL (e.g., "http://loki:3100"), the push API is used when the path is empty
+//   - proxyURL: HTTP/S proxy URL (optional)
+//   - tlsConfig: TLS configuration (optional)
+//   - tenantID: the tenant sent in the X-Scope-OrgID header (optional)
+//   - username: Username for basic authentication (optional)
+//   - password: Password for basic authentication (optional)
+//   - token: Bearer token used when username is empty (optional)
+//   - batch: the size and flush interval of the batches (optional)
+//   - opts: the metadata keys added to the labels (optional)
+//
+// Returns an error if the address is invalid.
+func NewLoki(providerUID string, address string, proxyURL string, tlsConfig *tls.Config,
+	tenantID string, username string, password string, token string,
+	batch *apiv1beta3.BatchOptions, opts *apiv1beta3.LokiOptions) (*Loki, error) {
+	u, err := url.ParseRequestURI(address)
+	if err != nil {
+		return nil, fmt.Errorf("invalid Loki URL (address) %q: %w", address, err)
+	}
+	if u.Path == "" || u.Path == "/" {
+		u.Path = lokiPushPath
+	}
+
+	l := &Loki{
+		URL:       u.String(),
+		ProxyURL:  proxyURL,
+		TLSConfig: tlsConfig,
+		TenantID:  tenantID,
+		Username:  username,
+		Password:  password,
+		Token:     token,
+		batchKey:  "loki/" + providerUID,
+		batch:     newBatchOptions(batch),
+	}
+	if opts != nil {
+		for _, key := range opts.MetadataLabels {
+			if key = strings.TrimSpace(key); key != "" {
+				l.MetadataLabels = append(l.MetadataLabels, key)
+			}
+		}
+	}
+
+	return l, nil
+}
+
+// Post adds the event to the batch of the provider, and returns once the batch is pushed to Loki.
+func (l *Loki) Post(ctx context.Context, event eventv1.Event) error {
+	timestamp := event.Timestamp.Time
+	if timestamp.IsZero() {
+		timestamp = time.Now()
+	}
+
+	payload, err := json.Marshal(lokiStream{
+		Stream: l.labels(event),
+		Values: [][2]string{{strconv.FormatInt(timestamp.UnixNano(), 10), event.Message}},
+	})
+	if err != nil {
+		return fmt.Errorf("error json-marshaling event: %w", err)
+	}
+
+	if err := addToBatch(ctx, l.batchKey, l.batch, l.flush, payload); err != nil {
+		return fmt.Errorf("error pushing events to Loki: %w", err)
+	}
+
+	// debug log
+	log.FromContext(ctx).V(1).Info("Event pushed to Loki", "tenant", l.TenantID)
+
+	return nil
+}
+
+// labels returns the stream labels of the event, labels with empty values are omitted.
+func (l *Loki) labels(event eventv1.Event) map[string]string {
+	labels := make(map[string]string)
+	for key, val := range map[string]string{
+		"kind":      event.InvolvedObject.Kind,
+		"namespace": event.InvolvedObject.Namespace,
+		"name":      event.InvolvedObject.Name,
+		"severity":  event.Severity,
+		"reason":    event.Reason,
+	} {
+		if val != "" {
+			labels[key] = val
+		}
+	}
+	for _, key := range l.MetadataLabels {
+		if val := event.Metadata[key]; val != "" {
+			labels[lokiLabelName(key)] = val
+		}
+	}
+	return labels
+}
+
+// flush pushes the batched events, merging the events with the same labels in a single stream.
+func (l *Loki) flush(ctx context.Context, items [][]byte) error {
+	var req lokiPushRequest
+	streams := make(map[string]int)
+	for _, item := range items {
+		var s lokiStream
+		if err := json.Unmarshal(item, &s); err != nil {
+			return fmt.Errorf("error json-unmarshaling stream: %w", err)
+		}
+		key := lokiStreamKey(s.Stream)
+		if i, ok := streams[key]; ok {
+			req.Streams[i].Values = append(req.Streams[i].Values, s.Values...)
+			continue
+		}
+		streams[key] = len(req.Streams)
+		req.Streams = append(req.Streams, s)
+	}
+
+	opts := []postOption{
+		withProxy(l.ProxyURL),
+		withTLSConfig(l.TLSConfig),
+		withRequestModifier(func(req *retryablehttp.Request) {
+			if l.TenantID != "" {
+				req.Header.Set("X-Scope-OrgID", l.TenantID)
+			}
+			if l.Username == "" && l.Token != "" {
+				req.Header.Set("Authorization", "Bearer "+l.Token)
+			}
+		}),
+	}
+	if l.Username != "" {
+		opts = append(opts, withBasicAuth(l.Username, l.Password))
+	}
+
+	return postMessage(ctx, l.URL, req, opts...)
+}
+
+// lokiStreamKey returns a key identifying the label set of a stream.
+func lokiStreamKey(labels map[string]string) string {
+	pairs := make([]string, 0, len(labels))
+	for k, v := range labels {
+		pairs = append(pairs, strconv.Quote(k)+"="+strconv.Quote(v))
+	}
+	sort.Strings(pairs)
+	return strings.Join(pairs, ",")
+}
+
+// lokiLabelName returns the metadata key as a valid label name,
+// replacing the characters not allowed in label names with underscores.
+func lokiLabelName(key string) string {
+	name := []byte(key)
+	for i, c := range name {
+		valid := c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (i > 0 && c >= '0' && c <= '9')
+		if !valid {
+			name[i] = '_'
+		}
+	}
+	return string(name)
+}
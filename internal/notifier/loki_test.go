@@ -0,0 +1,198 @@
+/*
+Copyright 2026 The Flux authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notifier
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"k8s.io/utils/ptr"
+
+	eventv1 "github.com/fluxcd/pkg/apis/event/v1beta1"
+
+	apiv1beta3 "github.com/fluxcd/notification-controller/api/v1beta3"
+)
+
+func TestNewLoki(t *testing.T) {
+	tests := []struct {
+		name                   string
+		address                string
+		opts                   *apiv1beta3.LokiOptions
+		expectedErr            string
+		expectedURL            string
+		expectedMetadataLabels []string
+	}{
+		{
+			name:        "invalid address",
+			address:     "loki",
+			expectedErr: "invalid Loki URL (address)",
+		},
+		{
+			name:        "push API by default",
+			address:     "http://loki:3100",
+			expectedURL: "http://loki:3100/loki/api/v1/push",
+		},
+		{
+			name:                   "custom path and metadata labels",
+			address:                "https://logs-prod.grafana.net/loki/api/v1/push",
+			opts:                   &apiv1beta3.LokiOptions{MetadataLabels: []string{" revision", "", "cluster "}},
+			expectedURL:            "https://logs-prod.grafana.net/loki/api/v1/push",
+			expectedMetadataLabels: []string{"revision", "cluster"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			l, err := NewLoki("uid", tt.address, "", nil, "tenant", "", "", "", nil, tt.opts)
+			if tt.expectedErr != "" {
+				g.Expect(err).To(MatchError(ContainSubstring(tt.expectedErr)))
+				g.Expect(l).To(BeNil())
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(l.URL).To(Equal(tt.expectedURL))
+			g.Expect(l.TenantID).To(Equal("tenant"))
+			g.Expect(l.MetadataLabels).To(Equal(tt.expectedMetadataLabels))
+		})
+	}
+}
+
+func TestLoki_Post(t *testing.T) {
+	event := eventv1.Event{
+		InvolvedObject: corev1.ObjectReference{
+			Kind:      "Kustomization",
+			Namespace: "flux-system",
+			Name:      "apps",
+		},
+		Severity:  eventv1.EventSeverityInfo,
+		Reason:    "ReconciliationSucceeded",
+		Message:   "Reconciliation finished in 1.2s",
+		Timestamp: metav1.NewTime(time.Unix(1792324800, 0)),
+		Metadata: map[string]string{
+			"revision":                          "main@sha1:e1f2a3b4",
+			"kustomize.toolkit.fluxcd.io/token": "secret",
+		},
+	}
+	other := event
+	other.InvolvedObject.Name = "infra"
+	other.Timestamp = metav1.NewTime(time.Unix(1792324801, 0))
+	repeated := event
+	repeated.Timestamp = metav1.NewTime(time.Unix(1792324802, 0))
+
+	tests := []struct {
+		name          string
+		username      string
+		password      string
+		token         string
+		expectedAuth  string
+		expectedOrgID string
+	}{
+		{
+			name:          "basic auth",
+			username:      "123456",
+			password:      "glc_token",
+			expectedAuth:  "Basic " + basicAuth("123456", "glc_token"),
+			expectedOrgID: "tenant",
+		},
+		{
+			name:          "bearer token",
+			token:         "token",
+			expectedAuth:  "Bearer token",
+			expectedOrgID: "tenant",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			var requests int
+			var req lokiPushRequest
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requests++
+				g.Expect(r.URL.Path).To(Equal("/loki/api/v1/push"))
+				g.Expect(r.Header.Get("Authorization")).To(Equal(tt.expectedAuth))
+				g.Expect(r.Header.Get("X-Scope-OrgID")).To(Equal(tt.expectedOrgID))
+				g.Expect(json.NewDecoder(r.Body).Decode(&req)).To(Succeed())
+				w.WriteHeader(http.StatusNoContent)
+			}))
+			defer ts.Close()
+
+			l, err := NewLoki(t.Name(), ts.URL, "", nil, "tenant", tt.username, tt.password, tt.token,
+				&apiv1beta3.BatchOptions{Size: ptr.To[int32](3)},
+				&apiv1beta3.LokiOptions{MetadataLabels: []string{"revision", "kustomize.toolkit.fluxcd.io/token", "missing"}})
+			g.Expect(err).ToNot(HaveOccurred())
+
+			errs := make(chan error, 3)
+			for _, e := range []eventv1.Event{event, other, repeated} {
+				go func() { errs <- l.Post(context.TODO(), e) }()
+			}
+			for range 3 {
+				g.Expect(<-errs).ToNot(HaveOccurred())
+			}
+
+			g.Expect(requests).To(Equal(1))
+			g.Expect(req.Streams).To(HaveLen(2))
+			labels := map[string]string{
+				"kind":                              "Kustomization",
+				"namespace":                         "flux-system",
+				"name":                              "apps",
+				"severity":                          "info",
+				"reason":                            "ReconciliationSucceeded",
+				"revision":                          "main@sha1:e1f2a3b4",
+				"kustomize_toolkit_fluxcd_io_token": "secret",
+			}
+			otherLabels := map[string]string{}
+			for k, v := range labels {
+				otherLabels[k] = v
+			}
+			otherLabels["name"] = "infra"
+			streams := map[string]lokiStream{}
+			for _, s := range req.Streams {
+				streams[s.Stream["name"]] = s
+			}
+			g.Expect(streams).To(HaveKey("apps"))
+			g.Expect(streams["apps"].Stream).To(Equal(labels))
+			g.Expect(streams["apps"].Values).To(ConsistOf(
+				[2]string{"1792324800000000000", "Reconciliation finished in 1.2s"},
+				[2]string{"1792324802000000000", "Reconciliation finished in 1.2s"},
+			))
+			g.Expect(streams).To(HaveKey("infra"))
+			g.Expect(streams["infra"].Stream).To(Equal(otherLabels))
+			g.Expect(streams["infra"].Values).To(Equal([][2]string{
+				{"1792324801000000000", "Reconciliation finished in 1.2s"},
+			}))
+		})
+	}
+}
+
+func TestLokiLabelName(t *testing.T) {
+	g := NewWithT(t)
+	g.Expect(lokiLabelName("revision")).To(Equal("revision"))
+	g.Expect(lokiLabelName("helm.toolkit.fluxcd.io/chart-version")).To(Equal("helm_toolkit_fluxcd_io_chart_version"))
+	g.Expect(lokiLabelName("1st")).To(Equal("_st"))
+}
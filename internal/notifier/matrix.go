@@ -59,6 +59,9 @@ func (m *Matrix) Post(ctx context.Context, event eventv1.Event) error {
 	heading := fmt.Sprintf("%s %s/%s.%s", emoji, strings.ToLower(event.InvolvedObject.Kind),
 		event.InvolvedObject.Name, event.InvolvedObject.Namespace)
 	msg := fmt.Sprintf("%s\n%s\n%s", heading, event.Message, metadata)
+	for _, l := range GetLinks(ctx) {
+		msg = msg + fmt.Sprintf("- %s: %s\n", l.Name, l.URL)
+	}
 
 	payload := MatrixPayload{
 		Body:    msg,
@@ -1,6 +1,10 @@
 package notifier
 
 import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 	"time"
 
@@ -42,3 +46,24 @@ func TestSha1Sum(t *testing.T) {
 		g.Expect(hash).To(Equal(tt.sha1))
 	}
 }
+
+func TestMatrix_PostLinks(t *testing.T) {
+	g := NewWithT(t)
+
+	var payload MatrixPayload
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		g.Expect(r.Method).To(Equal(http.MethodPut))
+		g.Expect(r.Header.Get("Authorization")).To(Equal("Bearer token"))
+		g.Expect(json.NewDecoder(r.Body).Decode(&payload)).To(Succeed())
+	}))
+	defer ts.Close()
+
+	matrix, err := NewMatrix(ts.URL, "token", "!room:example.com", nil)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	ctx := WithLinks(context.TODO(), []Link{{Name: "Dashboard", URL: "https://flux.example.com"}})
+	g.Expect(matrix.Post(ctx, testEvent())).To(Succeed())
+
+	g.Expect(payload.MsgType).To(Equal("m.text"))
+	g.Expect(payload.Body).To(HaveSuffix("- Dashboard: https://flux.example.com\n"))
+}
@@ -0,0 +1,200 @@
+/*
+Copyright 2026 The Flux authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notifier
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+
+	eventv1 "github.com/fluxcd/pkg/apis/event/v1beta1"
+	"github.com/hashicorp/go-retryablehttp"
+)
+
+const (
+	// mattermostPostsPath is the path of the REST API used to create posts.
+	mattermostPostsPath = "/api/v4/posts"
+
+	mattermostInfoColor  = "#2EB886"
+	mattermostErrorColor = "#A30200"
+)
+
+// Mattermost holds the incoming webhook URL, or the server URL and the
+// bot token when posting through the REST API.
+type Mattermost struct {
+	ProviderUID string
+	URL         string
+	ProxyURL    string
+	Token       string
+	Username    string
+	Channel     string
+	TLSConfig   *tls.Config
+}
+
+// MattermostPayload holds the incoming webhook message.
+type MattermostPayload struct {
+	Channel     string                 `json:"channel,omitempty"`
+	Username    string                 `json:"username,omitempty"`
+	Attachments []MattermostAttachment `json:"attachments"`
+}
+
+// MattermostPost holds the message created through the REST API.
+type MattermostPost struct {
+	ChannelID string              `json:"channel_id"`
+	RootID    string              `json:"root_id,omitempty"`
+	Props     MattermostPostProps `json:"props"`
+}
+
+// MattermostPostProps holds the attachments of a post.
+type MattermostPostProps struct {
+	Attachments []MattermostAttachment `json:"attachments"`
+}
+
+// MattermostAttachment holds the Markdown message body.
+// See https://developers.mattermost.com/integrate/reference/message-attachments/.
+type MattermostAttachment struct {
+	Fallback   string            `json:"fallback"`
+	Color      string            `json:"color"`
+	AuthorName string            `json:"author_name"`
+	Text       string            `json:"text"`
+	Fields     []MattermostField `json:"fields,omitempty"`
+}
+
+// MattermostField holds a metadata entry of the event.
+type MattermostField struct {
+	Title string `json:"title"`
+	Value string `json:"value"`
+	Short bool   `json:"short"`
+}
+
+// NewMattermost creates a new Mattermost notifier.
+//
+// Parameters:
+//   - providerUID: UID of the Provider, used to key the threads
+//   - address: incoming webhook URL, or the server URL when a token is set
+//   - proxyURL: Proxy URL (optional)
+//   - tlsConfig: TLS configuration (optional)
+//   - token: bot access token, enables the REST API and thread replies (optional)
+//   - username: overrides the username of the webhook (optional)
+//   - channel: channel name override of the webhook, or the channel ID
+//     required by the REST API
+func NewMattermost(providerUID string, address string, proxyURL string, tlsConfig *tls.Config,
+	token string, username string, channel string) (*Mattermost, error) {
+	if _, err := url.ParseRequestURI(address); err != nil {
+		return nil, fmt.Errorf("invalid Mattermost URL %s: '%w'", address, err)
+	}
+	if token != "" && channel == "" {
+		return nil, errors.New("Mattermost channel ID (channel) cannot be empty when using a bot token")
+	}
+
+	return &Mattermost{
+		ProviderUID: providerUID,
+		URL:         address,
+		ProxyURL:    proxyURL,
+		Token:       token,
+		Username:    username,
+		Channel:     channel,
+		TLSConfig:   tlsConfig,
+	}, nil
+}
+
+// Post posts the event to the Mattermost channel. With a bot token, the
+// events of an involved object are posted as replies to the thread
+// opened by its first event.
+func (m *Mattermost) Post(ctx context.Context, event eventv1.Event) error {
+	attachments := []MattermostAttachment{mattermostAttachment(ctx, event)}
+
+	opts := []postOption{}
+	if m.ProxyURL != "" {
+		opts = append(opts, withProxy(m.ProxyURL))
+	}
+	if m.TLSConfig != nil {
+		opts = append(opts, withTLSConfig(m.TLSConfig))
+	}
+
+	if m.Token == "" {
+		payload := MattermostPayload{
+			Channel:     m.Channel,
+			Username:    m.Username,
+			Attachments: attachments,
+		}
+		if err := postMessage(ctx, m.URL, payload, opts...); err != nil {
+			return fmt.Errorf("postMessage failed: %w", err)
+		}
+		return nil
+	}
+
+	rootID := getThread(m.ProviderUID, m.Channel, event)
+	payload := MattermostPost{
+		ChannelID: m.Channel,
+		RootID:    rootID,
+		Props:     MattermostPostProps{Attachments: attachments},
+	}
+
+	var created struct {
+		ID string `json:"id"`
+	}
+	opts = append(opts,
+		withRequestModifier(func(request *retryablehttp.Request) {
+			request.Header.Set("Authorization", "Bearer "+m.Token)
+		}),
+		withResponseBody(&created))
+
+	address := strings.TrimSuffix(m.URL, "/") + mattermostPostsPath
+	if err := postMessage(ctx, address, payload, opts...); err != nil {
+		return fmt.Errorf("postMessage failed: %w", err)
+	}
+
+	if rootID == "" && created.ID != "" {
+		setThread(m.ProviderUID, m.Channel, event, created.ID)
+	}
+
+	return nil
+}
+
+// mattermostAttachment returns the attachment of the event, colored by
+// severity, with the metadata as fields and the links appended to the text.
+func mattermostAttachment(ctx context.Context, event eventv1.Event) MattermostAttachment {
+	color := mattermostInfoColor
+	if event.Severity == eventv1.EventSeverityError {
+		color = mattermostErrorColor
+	}
+
+	keys := make([]string, 0, len(event.Metadata))
+	for k := range event.Metadata {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	fields := make([]MattermostField, 0, len(keys))
+	for _, k := range keys {
+		fields = append(fields, MattermostField{Title: k, Value: event.Metadata[k], Short: true})
+	}
+
+	author := fmt.Sprintf("%s/%s.%s", strings.ToLower(event.InvolvedObject.Kind),
+		event.InvolvedObject.Name, event.InvolvedObject.Namespace)
+	return MattermostAttachment{
+		Fallback:   fmt.Sprintf("%s: %s", author, strings.Split(event.Message, "\n")[0]),
+		Color:      color,
+		AuthorName: author,
+		Text:       event.Message + markdownLinks(GetLinks(ctx)),
+		Fields:     fields,
+	}
+}
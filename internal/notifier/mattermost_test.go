@@ -0,0 +1,153 @@
+/*
+Copyright 2026 The Flux authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notifier
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+
+	eventv1 "github.com/fluxcd/pkg/apis/event/v1beta1"
+)
+
+func TestNewMattermost(t *testing.T) {
+	tests := []struct {
+		name        string
+		address     string
+		token       string
+		channel     string
+		expectedErr string
+	}{
+		{
+			name:        "invalid address",
+			address:     "mattermost",
+			expectedErr: "invalid Mattermost URL mattermost",
+		},
+		{
+			name:        "token requires a channel ID",
+			address:     "https://mattermost.example.com",
+			token:       "bot-token",
+			expectedErr: "Mattermost channel ID (channel) cannot be empty when using a bot token",
+		},
+		{
+			name:    "incoming webhook",
+			address: "https://mattermost.example.com/hooks/xxx",
+		},
+		{
+			name:    "REST API",
+			address: "https://mattermost.example.com",
+			token:   "bot-token",
+			channel: "4xp9fdt77pncbef59f4k1qe83o",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			m, err := NewMattermost("uid", tt.address, "", nil, tt.token, "flux", tt.channel)
+			if tt.expectedErr != "" {
+				g.Expect(err).To(MatchError(ContainSubstring(tt.expectedErr)))
+				g.Expect(m).To(BeNil())
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(m.URL).To(Equal(tt.address))
+			g.Expect(m.Token).To(Equal(tt.token))
+			g.Expect(m.Channel).To(Equal(tt.channel))
+		})
+	}
+}
+
+func TestMattermost_PostWebhook(t *testing.T) {
+	g := NewWithT(t)
+
+	var payload MattermostPayload
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		g.Expect(r.URL.Path).To(Equal("/hooks/xxx"))
+		g.Expect(r.Header.Get("Authorization")).To(BeEmpty())
+		g.Expect(json.NewDecoder(r.Body).Decode(&payload)).To(Succeed())
+	}))
+	defer ts.Close()
+
+	m, err := NewMattermost("uid", ts.URL+"/hooks/xxx", "", nil, "", "flux", "town-square")
+	g.Expect(err).ToNot(HaveOccurred())
+
+	event := testEvent()
+	event.Severity = eventv1.EventSeverityError
+	ctx := WithLinks(context.TODO(), []Link{{Name: "Dashboard", URL: "https://flux.example.com"}})
+	g.Expect(m.Post(ctx, event)).To(Succeed())
+
+	g.Expect(payload.Channel).To(Equal("town-square"))
+	g.Expect(payload.Username).To(Equal("flux"))
+	g.Expect(payload.Attachments).To(HaveLen(1))
+	a := payload.Attachments[0]
+	g.Expect(a.Color).To(Equal(mattermostErrorColor))
+	g.Expect(a.AuthorName).To(Equal("gitrepository/webapp.gitops-system"))
+	g.Expect(a.Text).To(Equal(event.Message + "\n\n[Dashboard](https://flux.example.com)"))
+	g.Expect(a.Fields).To(Equal([]MattermostField{{Title: "test", Value: "metadata", Short: true}}))
+}
+
+func TestMattermost_PostThreads(t *testing.T) {
+	g := NewWithT(t)
+
+	var posts []MattermostPost
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		g.Expect(r.URL.Path).To(Equal("/api/v4/posts"))
+		g.Expect(r.Header.Get("Authorization")).To(Equal("Bearer bot-token"))
+		var post MattermostPost
+		g.Expect(json.NewDecoder(r.Body).Decode(&post)).To(Succeed())
+		posts = append(posts, post)
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprintf(w, `{"id":"post%d"}`, len(posts))
+	}))
+	defer ts.Close()
+
+	m, err := NewMattermost("mattermost-threads", ts.URL+"/", "", nil, "bot-token", "", "channel-id")
+	g.Expect(err).ToNot(HaveOccurred())
+
+	event := eventv1.Event{
+		InvolvedObject: corev1.ObjectReference{
+			Kind:      "Kustomization",
+			Namespace: "flux-system",
+			Name:      "apps",
+			UID:       "2e7a9a3e-5c1f-4a8e-9d7b-0f1c2d3e4f50",
+		},
+		Severity: eventv1.EventSeverityInfo,
+		Message:  "Reconciliation finished",
+	}
+	other := event
+	other.InvolvedObject.Name = "infra"
+	other.InvolvedObject.UID = "7c2b1a0f-3e4d-4c5b-8a9f-1e2d3c4b5a60"
+
+	g.Expect(m.Post(context.TODO(), event)).To(Succeed())
+	g.Expect(m.Post(context.TODO(), event)).To(Succeed())
+	g.Expect(m.Post(context.TODO(), other)).To(Succeed())
+
+	g.Expect(posts).To(HaveLen(3))
+	g.Expect(posts[0].ChannelID).To(Equal("channel-id"))
+	g.Expect(posts[0].RootID).To(BeEmpty())
+	g.Expect(posts[0].Props.Attachments[0].Color).To(Equal(mattermostInfoColor))
+	g.Expect(posts[1].RootID).To(Equal("post1"))
+	g.Expect(posts[2].RootID).To(BeEmpty())
+}
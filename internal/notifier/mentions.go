@@ -0,0 +1,36 @@
+/*
+Copyright 2026 The Flux authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notifier
+
+import (
+	"context"
+
+	apiv1beta3 "github.com/fluxcd/notification-controller/api/v1beta3"
+)
+
+type mentionsContextKey struct{}
+
+// WithMentions returns a context carrying the mentions configured on the Alert.
+func WithMentions(ctx context.Context, mentions []apiv1beta3.Mention) context.Context {
+	return context.WithValue(ctx, mentionsContextKey{}, mentions)
+}
+
+// GetMentions returns the mentions configured on the Alert, if any.
+func GetMentions(ctx context.Context) []apiv1beta3.Mention {
+	mentions, _ := ctx.Value(mentionsContextKey{}).([]apiv1beta3.Mention)
+	return mentions
+}
@@ -0,0 +1,181 @@
+/*
+Copyright 2026 The Flux authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notifier
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/tls"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"text/template"
+	"time"
+
+	mqtt "github.com/eclipse/paho.mqtt.golang"
+	eventv1 "github.com/fluxcd/pkg/apis/event/v1beta1"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	apiv1beta3 "github.com/fluxcd/notification-controller/api/v1beta3"
+)
+
+// mqttDefaultTopic is the topic template used when the channel is not set.
+const mqttDefaultTopic = "flux/{{ .InvolvedObject.Namespace }}/{{ .InvolvedObject.Kind }}/{{ .InvolvedObject.Name }}"
+
+type (
+	// MQTT holds an MQTT client and the target topic template.
+	MQTT struct {
+		topic  *template.Template
+		qos    byte
+		retain bool
+		client interface {
+			publish(ctx context.Context, topic string, qos byte, retain bool, payload []byte) error
+		}
+	}
+
+	mqttClient struct {
+		broker    string
+		username  string
+		password  string
+		tlsConfig *tls.Config
+	}
+)
+
+// NewMQTT creates a new MQTT notifier.
+//
+// Parameters:
+//   - broker: MQTT broker URL (e.g., "tcp://mosquitto:1883" or "ssl://mosquitto:8883")
+//   - topic: Go template of the topic, rendered with the event (optional)
+//   - username: Username for authentication (optional)
+//   - password: Password for authentication (optional)
+//   - tlsConfig: TLS configuration, e.g. with client certificates (optional)
+//   - opts: the QoS level (0, 1 or 2, default 1) and whether the messages
+//     are published as retained messages (optional)
+//
+// Returns an error if broker is empty or the topic template is invalid.
+func NewMQTT(broker string, topic string, username string, password string,
+	tlsConfig *tls.Config, opts *apiv1beta3.MQTTOptions) (*MQTT, error) {
+	if broker == "" {
+		return nil, errors.New("MQTT broker (address) cannot be empty")
+	}
+
+	if topic == "" {
+		topic = mqttDefaultTopic
+	}
+	tmpl, err := template.New("topic").Option("missingkey=zero").Parse(topic)
+	if err != nil {
+		return nil, fmt.Errorf("invalid MQTT topic template (channel): %w", err)
+	}
+
+	m := &MQTT{
+		topic: tmpl,
+		qos:   1,
+		client: &mqttClient{
+			broker:    broker,
+			username:  username,
+			password:  password,
+			tlsConfig: tlsConfig,
+		},
+	}
+
+	if opts != nil {
+		if opts.QoS != nil {
+			if qos := *opts.QoS; qos < 0 || qos > 2 {
+				return nil, fmt.Errorf("invalid MQTT QoS '%d', must be 0, 1 or 2", qos)
+			}
+			m.qos = byte(*opts.QoS)
+		}
+		m.retain = opts.Retain
+	}
+
+	return m, nil
+}
+
+// Post publishes Flux events to the MQTT topic rendered for the event.
+func (m *MQTT) Post(ctx context.Context, event eventv1.Event) error {
+	var sb strings.Builder
+	if err := m.topic.Execute(&sb, event); err != nil {
+		return fmt.Errorf("error rendering topic: %w", err)
+	}
+	topic := sb.String()
+	if topic == "" || strings.ContainsAny(topic, "+#") {
+		return fmt.Errorf("invalid topic '%s', must be non-empty and cannot contain wildcards", topic)
+	}
+
+	eventPayload, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("error json-marshaling event: %w", err)
+	}
+
+	if err := m.client.publish(ctx, topic, m.qos, m.retain, eventPayload); err != nil {
+		return fmt.Errorf("error publishing event to topic %s: %w", topic, err)
+	}
+
+	// debug log
+	log.FromContext(ctx).V(1).Info("Event published to MQTT topic", "topic", topic)
+
+	return nil
+}
+
+func (m *mqttClient) publish(ctx context.Context, topic string, qos byte, retain bool, payload []byte) error {
+	// Use a unique client ID, brokers disconnect clients sharing the same ID.
+	suffix := make([]byte, 4)
+	if _, err := rand.Read(suffix); err != nil {
+		return err
+	}
+
+	opts := mqtt.NewClientOptions().
+		AddBroker(m.broker).
+		SetClientID("notification-controller-" + hex.EncodeToString(suffix)).
+		SetCleanSession(true).
+		SetAutoReconnect(false).
+		SetConnectRetry(false)
+	if m.username != "" {
+		opts.SetUsername(m.username)
+		opts.SetPassword(m.password)
+	}
+	if m.tlsConfig != nil {
+		opts.SetTLSConfig(m.tlsConfig)
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		opts.SetConnectTimeout(time.Until(deadline))
+	}
+
+	c := mqtt.NewClient(opts)
+	if err := waitMQTTToken(ctx, c.Connect()); err != nil {
+		return fmt.Errorf("error connecting to broker: %w", err)
+	}
+	defer c.Disconnect(250)
+
+	if err := waitMQTTToken(ctx, c.Publish(topic, qos, retain, payload)); err != nil {
+		return fmt.Errorf("error publishing message to broker: %w", err)
+	}
+
+	return nil
+}
+
+// waitMQTTToken waits for the completion of the token, or for the context to be done.
+func waitMQTTToken(ctx context.Context, token mqtt.Token) error {
+	select {
+	case <-token.Done():
+		return token.Error()
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,186 @@
+/*
+Copyright 2026 The Flux authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notifier
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/ptr"
+
+	eventv1 "github.com/fluxcd/pkg/apis/event/v1beta1"
+
+	apiv1beta3 "github.com/fluxcd/notification-controller/api/v1beta3"
+)
+
+func TestNewMQTT(t *testing.T) {
+	tests := []struct {
+		name           string
+		broker         string
+		topic          string
+		username       string
+		password       string
+		tlsConfig      *tls.Config
+		opts           *apiv1beta3.MQTTOptions
+		expectedErr    string
+		expectedQoS    byte
+		expectedRetain bool
+	}{
+		{
+			name:        "empty broker is not allowed",
+			expectedErr: "MQTT broker (address) cannot be empty",
+		},
+		{
+			name:        "invalid topic template",
+			broker:      "tcp://mosquitto:1883",
+			topic:       "flux/{{ .InvolvedObject.Name",
+			expectedErr: "invalid MQTT topic template (channel)",
+		},
+		{
+			name:        "defaults",
+			broker:      "tcp://mosquitto:1883",
+			expectedQoS: 1,
+		},
+		{
+			name:           "QoS and retain",
+			broker:         "ssl://mosquitto:8883",
+			username:       "user",
+			password:       "pass",
+			tlsConfig:      &tls.Config{},
+			opts:           &apiv1beta3.MQTTOptions{QoS: ptr.To[int32](2), Retain: true},
+			expectedQoS:    2,
+			expectedRetain: true,
+		},
+		{
+			name:        "QoS 0",
+			broker:      "tcp://mosquitto:1883",
+			opts:        &apiv1beta3.MQTTOptions{QoS: ptr.To[int32](0)},
+			expectedQoS: 0,
+		},
+		{
+			name:        "invalid QoS",
+			broker:      "tcp://mosquitto:1883",
+			opts:        &apiv1beta3.MQTTOptions{QoS: ptr.To[int32](3)},
+			expectedErr: "invalid MQTT QoS '3'",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			provider, err := NewMQTT(tt.broker, tt.topic, tt.username, tt.password, tt.tlsConfig, tt.opts)
+			if tt.expectedErr != "" {
+				g.Expect(err).To(MatchError(ContainSubstring(tt.expectedErr)))
+				g.Expect(provider).To(BeNil())
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(provider.qos).To(Equal(tt.expectedQoS))
+			g.Expect(provider.retain).To(Equal(tt.expectedRetain))
+
+			client := provider.client.(*mqttClient)
+			g.Expect(client.broker).To(Equal(tt.broker))
+			g.Expect(client.username).To(Equal(tt.username))
+			g.Expect(client.password).To(Equal(tt.password))
+			g.Expect(client.tlsConfig).To(Equal(tt.tlsConfig))
+		})
+	}
+}
+
+type fakeMQTTClient struct {
+	topic      string
+	qos        byte
+	retain     bool
+	payload    string
+	publishErr error
+}
+
+func (f *fakeMQTTClient) publish(ctx context.Context, topic string, qos byte, retain bool, payload []byte) error {
+	f.topic = topic
+	f.qos = qos
+	f.retain = retain
+	f.payload = string(payload)
+	return f.publishErr
+}
+
+func TestMQTTPost(t *testing.T) {
+	event := eventv1.Event{
+		InvolvedObject: corev1.ObjectReference{
+			Kind:      "Kustomization",
+			Namespace: "flux-system",
+			Name:      "apps",
+		},
+		Metadata: map[string]string{"foo": "bar"},
+	}
+
+	tests := []struct {
+		name          string
+		topic         string
+		publishErr    error
+		expectedTopic string
+		expectedErr   string
+	}{
+		{
+			name:          "default topic",
+			expectedTopic: "flux/flux-system/Kustomization/apps",
+		},
+		{
+			name:          "custom topic",
+			topic:         "edge/cluster-1/{{ .InvolvedObject.Kind }}/{{ .InvolvedObject.Name }}/{{ .Severity }}",
+			expectedTopic: "edge/cluster-1/Kustomization/apps/info",
+		},
+		{
+			name:        "wildcards are not allowed",
+			topic:       "flux/#",
+			expectedErr: "invalid topic 'flux/#', must be non-empty and cannot contain wildcards",
+		},
+		{
+			name:        "publish error is wrapped and relayed",
+			publishErr:  errors.New("connection refused"),
+			expectedErr: "error publishing event to topic flux/flux-system/Kustomization/apps: connection refused",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			m, err := NewMQTT("tcp://mosquitto:1883", tt.topic, "", "", nil, &apiv1beta3.MQTTOptions{Retain: true})
+			g.Expect(err).ToNot(HaveOccurred())
+			client := &fakeMQTTClient{publishErr: tt.publishErr}
+			m.client = client
+
+			e := event
+			e.Severity = eventv1.EventSeverityInfo
+			err = m.Post(context.TODO(), e)
+			if tt.expectedErr != "" {
+				g.Expect(err).To(MatchError(tt.expectedErr))
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(client.topic).To(Equal(tt.expectedTopic))
+			g.Expect(client.qos).To(Equal(byte(1)))
+			g.Expect(client.retain).To(BeTrue())
+			g.Expect(client.payload).To(ContainSubstring(`"metadata":{"foo":"bar"}`))
+		})
+	}
+}
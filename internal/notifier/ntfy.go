@@ -0,0 +1,160 @@
+/*
+Copyright 2026 The Flux authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notifier
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net/url"
+	"path"
+	"strings"
+
+	eventv1 "github.com/fluxcd/pkg/apis/event/v1beta1"
+	"github.com/hashicorp/go-retryablehttp"
+)
+
+// ntfyMaxActions is the maximum number of actions of a ntfy notification.
+const ntfyMaxActions = 3
+
+// Ntfy holds the ntfy server URL, the topic and the credentials.
+type Ntfy struct {
+	URL       string
+	Topic     string
+	ProxyURL  string
+	Username  string
+	Password  string
+	Token     string
+	TLSConfig *tls.Config
+}
+
+// NtfyPayload holds the notification published as JSON.
+// See https://docs.ntfy.sh/publish/#publish-as-json.
+type NtfyPayload struct {
+	Topic    string       `json:"topic"`
+	Title    string       `json:"title"`
+	Message  string       `json:"message"`
+	Priority int          `json:"priority"`
+	Tags     []string     `json:"tags"`
+	Click    string       `json:"click,omitempty"`
+	Actions  []NtfyAction `json:"actions,omitempty"`
+}
+
+// NtfyAction holds a view action button of the notification.
+type NtfyAction struct {
+	Action string `json:"action"`
+	Label  string `json:"label"`
+	URL    string `json:"url"`
+}
+
+// NewNtfy creates a new ntfy notifier.
+//
+// Parameters:
+//   - address: ntfy server URL, optionally with the topic as path (e.g., "https://ntfy.sh/flux")
+//   - proxyURL: Proxy URL (optional)
+//   - tlsConfig: TLS configuration (optional)
+//   - topic: topic to publish to, overrides the topic of the address (optional)
+//   - username: Username for basic authentication (optional)
+//   - password: Password for basic authentication (optional)
+//   - token: access token, takes precedence over basic authentication (optional)
+//
+// Returns an error if the address is invalid or no topic is set.
+func NewNtfy(address string, proxyURL string, tlsConfig *tls.Config, topic string,
+	username string, password string, token string) (*Ntfy, error) {
+	u, err := url.ParseRequestURI(address)
+	if err != nil {
+		return nil, fmt.Errorf("invalid ntfy server URL (address) %q: %w", address, err)
+	}
+
+	// The JSON messages are published to the root URL of the server. When
+	// the topic is not set, the last path segment of the address is the
+	// topic, otherwise the path is kept for servers behind a subpath.
+	if topic == "" {
+		dir, base := path.Split(strings.TrimSuffix(u.Path, "/"))
+		u.Path = dir
+		topic = base
+	}
+	if topic == "" {
+		return nil, errors.New("ntfy topic (channel) cannot be empty")
+	}
+
+	return &Ntfy{
+		URL:       u.String(),
+		Topic:     topic,
+		ProxyURL:  proxyURL,
+		Username:  username,
+		Password:  password,
+		Token:     token,
+		TLSConfig: tlsConfig,
+	}, nil
+}
+
+// Post publishes the event to the ntfy topic, with the priority and
+// the tags set by severity, and the links as click URL and actions.
+func (n *Ntfy) Post(ctx context.Context, event eventv1.Event) error {
+	title, message := formatPushNotification(event)
+	payload := NtfyPayload{
+		Topic:   n.Topic,
+		Title:   title,
+		Message: message,
+	}
+
+	switch event.Severity {
+	case eventv1.EventSeverityError:
+		payload.Priority = 4
+		payload.Tags = []string{"rotating_light"}
+	case eventv1.EventSeverityTrace:
+		payload.Priority = 2
+		payload.Tags = []string{"mag"}
+	default:
+		payload.Priority = 3
+		payload.Tags = []string{"dizzy"}
+	}
+	payload.Tags = append(payload.Tags, strings.ToLower(event.InvolvedObject.Kind))
+
+	for i, l := range GetLinks(ctx) {
+		if i == 0 {
+			payload.Click = l.URL
+		}
+		if i < ntfyMaxActions {
+			payload.Actions = append(payload.Actions, NtfyAction{Action: "view", Label: l.Name, URL: l.URL})
+		}
+	}
+
+	var opts []postOption
+	if n.ProxyURL != "" {
+		opts = append(opts, withProxy(n.ProxyURL))
+	}
+	if n.TLSConfig != nil {
+		opts = append(opts, withTLSConfig(n.TLSConfig))
+	}
+	switch {
+	case n.Token != "":
+		opts = append(opts, withRequestModifier(func(req *retryablehttp.Request) {
+			req.Header.Set("Authorization", "Bearer "+n.Token)
+		}))
+	case n.Username != "":
+		opts = append(opts, withBasicAuth(n.Username, n.Password))
+	}
+
+	if err := postMessage(ctx, n.URL, payload, opts...); err != nil {
+		return fmt.Errorf("postMessage failed: %w", err)
+	}
+
+	return nil
+}
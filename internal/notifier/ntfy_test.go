@@ -0,0 +1,184 @@
+/*
+Copyright 2026 The Flux authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notifier
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	eventv1 "github.com/fluxcd/pkg/apis/event/v1beta1"
+)
+
+func TestNewNtfy(t *testing.T) {
+	tests := []struct {
+		name          string
+		address       string
+		topic         string
+		expectedErr   string
+		expectedURL   string
+		expectedTopic string
+	}{
+		{
+			name:        "invalid address",
+			address:     "ntfy",
+			expectedErr: "invalid ntfy server URL (address)",
+		},
+		{
+			name:        "topic is required",
+			address:     "https://ntfy.sh",
+			expectedErr: "ntfy topic (channel) cannot be empty",
+		},
+		{
+			name:          "topic from address",
+			address:       "https://ntfy.sh/flux-alerts",
+			expectedURL:   "https://ntfy.sh/",
+			expectedTopic: "flux-alerts",
+		},
+		{
+			name:          "topic from channel",
+			address:       "https://ntfy.example.com/",
+			topic:         "homelab",
+			expectedURL:   "https://ntfy.example.com/",
+			expectedTopic: "homelab",
+		},
+		{
+			name:          "topic from address with subpath",
+			address:       "https://ntfy.example.com/ntfy/flux-alerts",
+			expectedURL:   "https://ntfy.example.com/ntfy/",
+			expectedTopic: "flux-alerts",
+		},
+		{
+			name:          "channel with subpath address",
+			address:       "https://ntfy.example.com/ntfy",
+			topic:         "flux",
+			expectedURL:   "https://ntfy.example.com/ntfy",
+			expectedTopic: "flux",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			n, err := NewNtfy(tt.address, "", nil, tt.topic, "", "", "")
+			if tt.expectedErr != "" {
+				g.Expect(err).To(MatchError(ContainSubstring(tt.expectedErr)))
+				g.Expect(n).To(BeNil())
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(n.URL).To(Equal(tt.expectedURL))
+			g.Expect(n.Topic).To(Equal(tt.expectedTopic))
+		})
+	}
+}
+
+func TestNtfy_Post(t *testing.T) {
+	tests := []struct {
+		name             string
+		severity         string
+		username         string
+		password         string
+		token            string
+		links            []Link
+		expectedAuth     string
+		expectedPriority int
+		expectedTags     []string
+		expectedClick    string
+		expectedActions  []NtfyAction
+	}{
+		{
+			name:             "info event without auth",
+			severity:         eventv1.EventSeverityInfo,
+			expectedPriority: 3,
+			expectedTags:     []string{"dizzy", "gitrepository"},
+		},
+		{
+			name:             "error event with token and links",
+			severity:         eventv1.EventSeverityError,
+			token:            "tk_token",
+			links:            []Link{{Name: "Dashboard", URL: "https://flux.example.com"}, {Name: "Runbook", URL: "https://runbooks.example.com"}},
+			expectedAuth:     "Bearer tk_token",
+			expectedPriority: 4,
+			expectedTags:     []string{"rotating_light", "gitrepository"},
+			expectedClick:    "https://flux.example.com",
+			expectedActions: []NtfyAction{
+				{Action: "view", Label: "Dashboard", URL: "https://flux.example.com"},
+				{Action: "view", Label: "Runbook", URL: "https://runbooks.example.com"},
+			},
+		},
+		{
+			name:             "basic auth",
+			severity:         eventv1.EventSeverityInfo,
+			username:         "flux",
+			password:         "pass",
+			expectedAuth:     "Basic " + basicAuth("flux", "pass"),
+			expectedPriority: 3,
+			expectedTags:     []string{"dizzy", "gitrepository"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			var payload NtfyPayload
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				g.Expect(r.URL.Path).To(Equal("/"))
+				g.Expect(r.Header.Get("Authorization")).To(Equal(tt.expectedAuth))
+				g.Expect(json.NewDecoder(r.Body).Decode(&payload)).To(Succeed())
+			}))
+			defer ts.Close()
+
+			n, err := NewNtfy(ts.URL+"/flux", "", nil, "", tt.username, tt.password, tt.token)
+			g.Expect(err).ToNot(HaveOccurred())
+
+			event := testEvent()
+			event.Severity = tt.severity
+			g.Expect(n.Post(WithLinks(context.TODO(), tt.links), event)).To(Succeed())
+
+			g.Expect(payload.Topic).To(Equal("flux"))
+			g.Expect(payload.Title).To(Equal("gitrepository/webapp.gitops-system"))
+			g.Expect(payload.Message).To(Equal("message\n\ntest: metadata"))
+			g.Expect(payload.Priority).To(Equal(tt.expectedPriority))
+			g.Expect(payload.Tags).To(Equal(tt.expectedTags))
+			g.Expect(payload.Click).To(Equal(tt.expectedClick))
+			g.Expect(payload.Actions).To(Equal(tt.expectedActions))
+		})
+	}
+}
+
+func TestNtfy_PostSubpath(t *testing.T) {
+	g := NewWithT(t)
+
+	var payload NtfyPayload
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		g.Expect(r.URL.Path).To(Equal("/ntfy"))
+		g.Expect(json.NewDecoder(r.Body).Decode(&payload)).To(Succeed())
+	}))
+	defer ts.Close()
+
+	n, err := NewNtfy(ts.URL+"/ntfy", "", nil, "flux", "", "", "")
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(n.Post(context.TODO(), testEvent())).To(Succeed())
+	g.Expect(payload.Topic).To(Equal("flux"))
+}
@@ -0,0 +1,137 @@
+/*
+Copyright 2026 The Flux authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notifier
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net/url"
+
+	eventv1 "github.com/fluxcd/pkg/apis/event/v1beta1"
+)
+
+const (
+	// pushoverDefaultURL is the Pushover messages API URL used when the address is not set.
+	pushoverDefaultURL = "https://api.pushover.net/1/messages.json"
+
+	// pushoverMaxTitleLength is the maximum number of characters of the message title.
+	pushoverMaxTitleLength = 250
+
+	// pushoverMaxMessageLength is the maximum number of characters of the message.
+	pushoverMaxMessageLength = 1024
+)
+
+// Pushover holds the Pushover API URL, the application token and the
+// user or group key of the recipients.
+type Pushover struct {
+	URL       string
+	ProxyURL  string
+	User      string
+	Token     string
+	TLSConfig *tls.Config
+}
+
+// PushoverPayload holds the message sent with the Pushover API.
+// See https://pushover.net/api#messages.
+type PushoverPayload struct {
+	Token    string `json:"token"`
+	User     string `json:"user"`
+	Title    string `json:"title"`
+	Message  string `json:"message"`
+	Priority int    `json:"priority"`
+	URL      string `json:"url,omitempty"`
+	URLTitle string `json:"url_title,omitempty"`
+}
+
+// NewPushover creates a new Pushover notifier.
+//
+// Parameters:
+//   - address: Pushover messages API URL, defaults to "https://api.pushover.net/1/messages.json"
+//   - proxyURL: Proxy URL (optional)
+//   - tlsConfig: TLS configuration (optional)
+//   - user: user or group key of the recipients
+//   - token: application API token
+//
+// Returns an error if the address is invalid, or the user or the token is empty.
+func NewPushover(address string, proxyURL string, tlsConfig *tls.Config, user string, token string) (*Pushover, error) {
+	if address == "" {
+		address = pushoverDefaultURL
+	}
+	if _, err := url.ParseRequestURI(address); err != nil {
+		return nil, fmt.Errorf("invalid Pushover URL (address) %q: %w", address, err)
+	}
+	if user == "" {
+		return nil, errors.New("Pushover user key (channel) cannot be empty")
+	}
+	if token == "" {
+		return nil, errors.New("Pushover application token cannot be empty")
+	}
+
+	return &Pushover{
+		URL:       address,
+		ProxyURL:  proxyURL,
+		User:      user,
+		Token:     token,
+		TLSConfig: tlsConfig,
+	}, nil
+}
+
+// Post sends the event to the Pushover recipients, with the priority and
+// the title emoji set by severity, and the first link as supplementary URL.
+// The title and the message are truncated to the lengths accepted by Pushover.
+func (p *Pushover) Post(ctx context.Context, event eventv1.Event) error {
+	title, message := formatPushNotification(event)
+	payload := PushoverPayload{
+		Token:   p.Token,
+		User:    p.User,
+		Message: truncateString(message, pushoverMaxMessageLength),
+	}
+
+	switch event.Severity {
+	case eventv1.EventSeverityError:
+		payload.Title = "🚨 " + title
+		payload.Priority = 1
+	case eventv1.EventSeverityTrace:
+		payload.Title = "🔍 " + title
+		payload.Priority = -1
+	default:
+		payload.Title = "💫 " + title
+		payload.Priority = 0
+	}
+	payload.Title = truncateString(payload.Title, pushoverMaxTitleLength)
+
+	if links := GetLinks(ctx); len(links) > 0 {
+		payload.URL = links[0].URL
+		payload.URLTitle = links[0].Name
+	}
+
+	var opts []postOption
+	if p.ProxyURL != "" {
+		opts = append(opts, withProxy(p.ProxyURL))
+	}
+	if p.TLSConfig != nil {
+		opts = append(opts, withTLSConfig(p.TLSConfig))
+	}
+
+	if err := postMessage(ctx, p.URL, payload, opts...); err != nil {
+		return fmt.Errorf("postMessage failed: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,141 @@
+/*
+Copyright 2026 The Flux authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notifier
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	eventv1 "github.com/fluxcd/pkg/apis/event/v1beta1"
+)
+
+func TestNewPushover(t *testing.T) {
+	tests := []struct {
+		name        string
+		address     string
+		user        string
+		token       string
+		expectedErr string
+		expectedURL string
+	}{
+		{
+			name:        "default address",
+			user:        "user-key",
+			token:       "app-token",
+			expectedURL: "https://api.pushover.net/1/messages.json",
+		},
+		{
+			name:        "invalid address",
+			address:     "pushover",
+			user:        "user-key",
+			token:       "app-token",
+			expectedErr: "invalid Pushover URL (address)",
+		},
+		{
+			name:        "user key is required",
+			token:       "app-token",
+			expectedErr: "Pushover user key (channel) cannot be empty",
+		},
+		{
+			name:        "token is required",
+			user:        "user-key",
+			expectedErr: "Pushover application token cannot be empty",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			p, err := NewPushover(tt.address, "", nil, tt.user, tt.token)
+			if tt.expectedErr != "" {
+				g.Expect(err).To(MatchError(ContainSubstring(tt.expectedErr)))
+				g.Expect(p).To(BeNil())
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(p.URL).To(Equal(tt.expectedURL))
+		})
+	}
+}
+
+func TestPushover_Post(t *testing.T) {
+	g := NewWithT(t)
+
+	var payload PushoverPayload
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		g.Expect(json.NewDecoder(r.Body).Decode(&payload)).To(Succeed())
+		_, _ = w.Write([]byte(`{"status":1,"request":"647d2300-702c-4b38-8b2f-d56326ae460b"}`))
+	}))
+	defer ts.Close()
+
+	p, err := NewPushover(ts.URL, "", nil, "user-key", "app-token")
+	g.Expect(err).ToNot(HaveOccurred())
+
+	event := testEvent()
+	event.Severity = eventv1.EventSeverityError
+	ctx := WithLinks(context.TODO(), []Link{{Name: "Dashboard", URL: "https://flux.example.com"}})
+	g.Expect(p.Post(ctx, event)).To(Succeed())
+
+	g.Expect(payload).To(Equal(PushoverPayload{
+		Token:    "app-token",
+		User:     "user-key",
+		Title:    "🚨 gitrepository/webapp.gitops-system",
+		Message:  "message\n\ntest: metadata",
+		Priority: 1,
+		URL:      "https://flux.example.com",
+		URLTitle: "Dashboard",
+	}))
+
+	event.Severity = eventv1.EventSeverityInfo
+	payload = PushoverPayload{}
+	g.Expect(p.Post(context.TODO(), event)).To(Succeed())
+	g.Expect(payload.Title).To(Equal("💫 gitrepository/webapp.gitops-system"))
+	g.Expect(payload.Priority).To(Equal(0))
+	g.Expect(payload.URL).To(BeEmpty())
+}
+
+func TestPushover_PostTruncates(t *testing.T) {
+	g := NewWithT(t)
+
+	var payload PushoverPayload
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		g.Expect(json.NewDecoder(r.Body).Decode(&payload)).To(Succeed())
+	}))
+	defer ts.Close()
+
+	p, err := NewPushover(ts.URL, "", nil, "user-key", "app-token")
+	g.Expect(err).ToNot(HaveOccurred())
+
+	event := testEvent()
+	event.Severity = eventv1.EventSeverityError
+	event.InvolvedObject.Name = strings.Repeat("a", 300)
+	event.Message = strings.Repeat("é", 2000)
+	g.Expect(p.Post(context.TODO(), event)).To(Succeed())
+
+	g.Expect([]rune(payload.Title)).To(HaveLen(250))
+	g.Expect(payload.Title).To(HavePrefix("🚨 gitrepository/aaa"))
+	g.Expect(payload.Title).To(HaveSuffix("..."))
+	g.Expect([]rune(payload.Message)).To(HaveLen(1024))
+	g.Expect(payload.Message).To(HaveSuffix("é..."))
+}
@@ -0,0 +1,43 @@
+/*
+Copyright 2026 The Flux authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notifier
+
+import (
+	"slices"
+
+	eventv1 "github.com/fluxcd/pkg/apis/event/v1beta1"
+	"github.com/fluxcd/pkg/apis/meta"
+)
+
+// RecoveryReasons are the reasons of the info events signaling that an
+// involved object reconciled successfully. Other info events, e.g. when a
+// dependency is not ready, don't mean that the object is no longer failing.
+var RecoveryReasons = []string{
+	meta.SucceededReason,
+	meta.ReconciliationSucceededReason,
+	"NewArtifact",
+	"InstallSucceeded",
+	"UpgradeSucceeded",
+	"TestSucceeded",
+}
+
+// IsRecoveryEvent returns if the given event signals that the involved object
+// is no longer failing, i.e. if it is an info event with one of the
+// RecoveryReasons.
+func IsRecoveryEvent(event *eventv1.Event) bool {
+	return event.Severity == eventv1.EventSeverityInfo && slices.Contains(RecoveryReasons, event.Reason)
+}
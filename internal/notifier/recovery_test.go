@@ -0,0 +1,79 @@
+/*
+Copyright 2026 The Flux authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notifier
+
+import (
+	"testing"
+
+	eventv1 "github.com/fluxcd/pkg/apis/event/v1beta1"
+	"github.com/fluxcd/pkg/apis/meta"
+	. "github.com/onsi/gomega"
+)
+
+func TestIsRecoveryEvent(t *testing.T) {
+	tests := []struct {
+		name     string
+		severity string
+		reason   string
+		want     bool
+	}{
+		{
+			name:     "reconciliation succeeded event",
+			severity: eventv1.EventSeverityInfo,
+			reason:   meta.ReconciliationSucceededReason,
+			want:     true,
+		},
+		{
+			name:     "upgrade succeeded event",
+			severity: eventv1.EventSeverityInfo,
+			reason:   "UpgradeSucceeded",
+			want:     true,
+		},
+		{
+			name:     "dependency not ready event",
+			severity: eventv1.EventSeverityInfo,
+			reason:   meta.DependencyNotReadyReason,
+			want:     false,
+		},
+		{
+			name:     "artifact up to date event",
+			severity: eventv1.EventSeverityInfo,
+			reason:   "ArtifactUpToDate",
+			want:     false,
+		},
+		{
+			name:     "progressing event",
+			severity: eventv1.EventSeverityInfo,
+			reason:   meta.ProgressingReason,
+			want:     false,
+		},
+		{
+			name:     "error event",
+			severity: eventv1.EventSeverityError,
+			reason:   meta.ReconciliationFailedReason,
+			want:     false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			event := &eventv1.Event{Severity: tt.severity, Reason: tt.reason}
+			g.Expect(IsRecoveryEvent(event)).To(Equal(tt.want))
+		})
+	}
+}
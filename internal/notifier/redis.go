@@ -0,0 +1,156 @@
+/*
+Copyright 2026 The Flux authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notifier
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	eventv1 "github.com/fluxcd/pkg/apis/event/v1beta1"
+	"github.com/redis/go-redis/v9"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	apiv1beta3 "github.com/fluxcd/notification-controller/api/v1beta3"
+)
+
+// redisMetadataPrefix is the prefix of the stream entry fields
+// holding the event metadata.
+const redisMetadataPrefix = "metadata."
+
+// Redis holds the connection options and the target stream.
+type Redis struct {
+	Stream          string
+	MaxLen          int64
+	ApproximateTrim bool
+	Options         *redis.Options
+}
+
+// NewRedis creates a new Redis Streams notifier.
+//
+// Parameters:
+//   - address: Redis server URL (e.g., "redis://redis:6379/0" or "rediss://redis:6380")
+//   - stream: the key of the stream to add events to
+//   - username: ACL username for authentication (optional)
+//   - password: password for authentication (optional)
+//   - tlsConfig: TLS configuration, e.g. with client certificates (optional)
+//   - streamOpts: the max length of the stream, and whether it is trimmed with "~" (optional)
+//
+// Returns an error if the address is invalid or the stream is empty.
+func NewRedis(address string, stream string, username string, password string,
+	tlsConfig *tls.Config, streamOpts *apiv1beta3.RedisOptions) (*Redis, error) {
+	if address == "" {
+		return nil, errors.New("Redis server (address) cannot be empty")
+	}
+	if stream == "" {
+		return nil, errors.New("Redis stream (channel) cannot be empty")
+	}
+
+	opts, err := redis.ParseURL(address)
+	if err != nil {
+		return nil, fmt.Errorf("invalid Redis server URL (address): %w", err)
+	}
+	if tlsConfig != nil {
+		if opts.TLSConfig == nil {
+			return nil, errors.New("invalid Redis server URL (address): scheme must be rediss when TLS is configured")
+		}
+		cfg := tlsConfig.Clone()
+		if cfg.ServerName == "" {
+			cfg.ServerName = opts.TLSConfig.ServerName
+		}
+		opts.TLSConfig = cfg
+	}
+	if username != "" {
+		opts.Username = username
+	}
+	if password != "" {
+		opts.Password = password
+	}
+
+	r := &Redis{
+		Stream:  stream,
+		Options: opts,
+	}
+
+	if streamOpts != nil {
+		if streamOpts.MaxLen < 0 {
+			return nil, fmt.Errorf("invalid Redis stream max length '%d', must be a positive integer", streamOpts.MaxLen)
+		}
+		r.MaxLen = streamOpts.MaxLen
+		r.ApproximateTrim = streamOpts.ApproximateTrim
+	}
+
+	return r, nil
+}
+
+// Post adds Flux events to the Redis stream, with the event fields as entry fields.
+func (r *Redis) Post(ctx context.Context, event eventv1.Event) error {
+	client := redis.NewClient(r.Options)
+	defer client.Close()
+
+	id, err := client.XAdd(ctx, &redis.XAddArgs{
+		Stream: r.Stream,
+		MaxLen: r.MaxLen,
+		Approx: r.ApproximateTrim,
+		Values: redisStreamValues(event),
+	}).Result()
+	if err != nil {
+		return fmt.Errorf("error adding event to stream %s: %w", r.Stream, err)
+	}
+
+	// debug log
+	log.FromContext(ctx).V(1).Info("Event added to Redis stream", "stream", r.Stream, "id", id)
+
+	return nil
+}
+
+// redisStreamValues returns the field-value pairs of the stream entry
+// for the event, with the metadata sorted by key.
+func redisStreamValues(event eventv1.Event) []string {
+	timestamp := ""
+	if !event.Timestamp.IsZero() {
+		timestamp = event.Timestamp.UTC().Format(time.RFC3339)
+	}
+
+	values := []string{
+		"kind", event.InvolvedObject.Kind,
+		"namespace", event.InvolvedObject.Namespace,
+		"name", event.InvolvedObject.Name,
+		"severity", event.Severity,
+		"reason", event.Reason,
+		"message", event.Message,
+		"timestamp", timestamp,
+		"reportingController", event.ReportingController,
+	}
+	if event.ReportingInstance != "" {
+		values = append(values, "reportingInstance", event.ReportingInstance)
+	}
+
+	keys := make([]string, 0, len(event.Metadata))
+	for k := range event.Metadata {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		values = append(values, redisMetadataPrefix+k, event.Metadata[k])
+	}
+
+	return values
+}
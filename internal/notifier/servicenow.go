@@ -0,0 +1,384 @@
+/*
+Copyright 2026 The Flux authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notifier
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-retryablehttp"
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/clientcredentials"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	eventv1 "github.com/fluxcd/pkg/apis/event/v1beta1"
+	"github.com/fluxcd/pkg/cache"
+
+	apiv1beta3 "github.com/fluxcd/notification-controller/api/v1beta3"
+)
+
+const (
+	// serviceNowIncidentPath is the Table API path of the incident table.
+	serviceNowIncidentPath = "/api/now/table/incident"
+
+	// serviceNowTokenPath is the path of the OAuth token endpoint.
+	serviceNowTokenPath = "/oauth_token.do"
+
+	// serviceNowResolvedState is the state of resolved incidents.
+	serviceNowResolvedState = "6"
+
+	// serviceNowDefaultUrgency is the urgency used when spec.servicenow.urgency is not set.
+	serviceNowDefaultUrgency = "1"
+
+	// serviceNowDefaultImpact is the impact used when spec.servicenow.impact is not set.
+	serviceNowDefaultImpact = "2"
+
+	// serviceNowDefaultCloseCode is the resolution code used when spec.servicenow.closeCode is not set.
+	serviceNowDefaultCloseCode = "Resolved by caller"
+)
+
+// ServiceNow holds the configuration for creating and resolving ServiceNow incidents.
+type ServiceNow struct {
+	URL             string
+	AssignmentGroup string
+	Category        string
+	Urgency         string
+	Impact          string
+	CloseCode       string
+	Username        string
+	Password        string
+	ClientID        string
+	ClientSecret    string
+	Client          *retryablehttp.Client
+
+	providerName      string
+	providerNamespace string
+	tokenCache        *cache.TokenCache
+}
+
+// serviceNowToken is an OAuth access token stored in the token cache.
+type serviceNowToken struct {
+	*oauth2.Token
+}
+
+// GetDuration implements cache.Token.
+func (t *serviceNowToken) GetDuration() time.Duration {
+	return time.Until(t.Expiry)
+}
+
+type serviceNowIncident struct {
+	SysID              string `json:"sys_id,omitempty"`
+	Number             string `json:"number,omitempty"`
+	ShortDescription   string `json:"short_description,omitempty"`
+	Description        string `json:"description,omitempty"`
+	CorrelationID      string `json:"correlation_id,omitempty"`
+	CorrelationDisplay string `json:"correlation_display,omitempty"`
+	Urgency            string `json:"urgency,omitempty"`
+	Impact             string `json:"impact,omitempty"`
+	AssignmentGroup    string `json:"assignment_group,omitempty"`
+	Category           string `json:"category,omitempty"`
+	WorkNotes          string `json:"work_notes,omitempty"`
+	State              string `json:"state,omitempty"`
+	CloseCode          string `json:"close_code,omitempty"`
+	CloseNotes         string `json:"close_notes,omitempty"`
+}
+
+// NewServiceNow creates a new ServiceNow notifier.
+//
+// Parameters:
+//   - address: ServiceNow instance URL (e.g., "https://example.service-now.com")
+//   - proxyURL: HTTP/S proxy URL (optional)
+//   - tlsConfig: TLS configuration (optional)
+//   - assignmentGroup: the name or sys_id of the group the incidents are assigned to (optional)
+//   - username: Username for basic authentication (optional)
+//   - password: Password for basic authentication (optional)
+//   - secretData: the "clientID" and "clientSecret" keys enable OAuth client credentials
+//   - opts: the category, urgency, impact and close code of the incidents (optional)
+//   - providerName, providerNamespace, tokenCache: cache the OAuth access tokens (optional)
+//
+// Returns an error if the address is invalid or the credentials are empty.
+func NewServiceNow(address string, proxyURL string, tlsConfig *tls.Config, assignmentGroup string,
+	username string, password string, secretData map[string][]byte, opts *apiv1beta3.ServiceNowOptions,
+	providerName string, providerNamespace string, tokenCache *cache.TokenCache) (*ServiceNow, error) {
+	if address == "" {
+		return nil, errors.New("ServiceNow instance URL (address) cannot be empty")
+	}
+	u, err := url.ParseRequestURI(address)
+	if err != nil {
+		return nil, fmt.Errorf("invalid ServiceNow instance URL (address) %q: %w", address, err)
+	}
+
+	secret := func(key string) string {
+		return strings.TrimSpace(string(secretData[key]))
+	}
+
+	s := &ServiceNow{
+		URL:               strings.TrimSuffix(u.String(), "/"),
+		AssignmentGroup:   assignmentGroup,
+		CloseCode:         serviceNowDefaultCloseCode,
+		Username:          username,
+		Password:          password,
+		ClientID:          secret("clientID"),
+		ClientSecret:      secret("clientSecret"),
+		providerName:      providerName,
+		providerNamespace: providerNamespace,
+		tokenCache:        tokenCache,
+	}
+	if s.ClientID == "" && (s.Username == "" || s.Password == "") {
+		return nil, errors.New("invalid credentials, expected to be one of username/password or clientID/clientSecret")
+	}
+	if s.ClientID != "" && s.ClientSecret == "" {
+		return nil, errors.New("ServiceNow clientSecret cannot be empty")
+	}
+	if opts != nil {
+		s.Category = opts.Category
+		s.Urgency = opts.Urgency
+		s.Impact = opts.Impact
+		if opts.CloseCode != "" {
+			s.CloseCode = opts.CloseCode
+		}
+	}
+
+	s.Client, err = newHTTPClient(&postOptions{proxy: proxyURL, tlsConfig: tlsConfig})
+	if err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+// Post creates an incident for a failing object, adds a work note to the active incident
+// of the object for repeated failures, and resolves the active incident when the object recovers.
+func (s *ServiceNow) Post(ctx context.Context, event eventv1.Event) error {
+	// skip the info events that don't signal a recovery, e.g. progressing events
+	if event.Severity != eventv1.EventSeverityError && !IsRecoveryEvent(&event) {
+		return nil
+	}
+
+	authorize, err := s.authorizer(ctx)
+	if err != nil {
+		return err
+	}
+
+	incident, err := s.findActiveIncident(ctx, authorize, event)
+	if err != nil {
+		return fmt.Errorf("failed searching for active incident: %w", err)
+	}
+
+	description := serviceNowDescription(ctx, event)
+	switch {
+	case event.Severity == eventv1.EventSeverityError && incident == nil:
+		created := &serviceNowIncident{}
+		if err := s.do(ctx, authorize, http.MethodPost, s.URL+serviceNowIncidentPath, s.newIncident(event, description), created); err != nil {
+			return fmt.Errorf("failed creating incident: %w", err)
+		}
+		log.FromContext(ctx).V(1).Info("ServiceNow incident created", "incident", created.Number)
+	case event.Severity == eventv1.EventSeverityError:
+		update := &serviceNowIncident{WorkNotes: description}
+		if err := s.do(ctx, authorize, http.MethodPatch, s.incidentURL(incident), update, nil); err != nil {
+			return fmt.Errorf("failed updating incident %s: %w", incident.Number, err)
+		}
+		log.FromContext(ctx).V(1).Info("ServiceNow incident updated", "incident", incident.Number)
+	case incident != nil && IsRecoveryEvent(&event):
+		update := &serviceNowIncident{
+			State:      serviceNowResolvedState,
+			CloseCode:  s.CloseCode,
+			CloseNotes: description,
+		}
+		if err := s.do(ctx, authorize, http.MethodPatch, s.incidentURL(incident), update, nil); err != nil {
+			return fmt.Errorf("failed resolving incident %s: %w", incident.Number, err)
+		}
+		log.FromContext(ctx).V(1).Info("ServiceNow incident resolved", "incident", incident.Number)
+	}
+
+	return nil
+}
+
+// findActiveIncident returns the latest incident of the involved object that
+// is not resolved, closed or canceled, or nil if there is none.
+func (s *ServiceNow) findActiveIncident(ctx context.Context, authorize func(*retryablehttp.Request),
+	event eventv1.Event) (*serviceNowIncident, error) {
+	query := url.Values{}
+	query.Set("sysparm_query", fmt.Sprintf("correlation_id=%s^stateNOT IN6,7,8^ORDERBYDESCsys_created_on",
+		involvedObjectID(event)))
+	query.Set("sysparm_fields", "sys_id,number")
+	query.Set("sysparm_limit", "1")
+
+	var incidents []serviceNowIncident
+	if err := s.do(ctx, authorize, http.MethodGet, s.URL+serviceNowIncidentPath+"?"+query.Encode(), nil, &incidents); err != nil {
+		return nil, err
+	}
+	if len(incidents) == 0 {
+		return nil, nil
+	}
+	return &incidents[0], nil
+}
+
+func (s *ServiceNow) newIncident(event eventv1.Event, description string) *serviceNowIncident {
+	shortDescription := fmt.Sprintf("%s/%s.%s: %s", event.InvolvedObject.Kind,
+		event.InvolvedObject.Name, event.InvolvedObject.Namespace, event.Reason)
+	urgency, impact := serviceNowDefaultUrgency, serviceNowDefaultImpact
+	if s.Urgency != "" {
+		urgency = s.Urgency
+	}
+	if s.Impact != "" {
+		impact = s.Impact
+	}
+	return &serviceNowIncident{
+		ShortDescription:   shortDescription,
+		Description:        description,
+		CorrelationID:      involvedObjectID(event),
+		CorrelationDisplay: "Flux",
+		Urgency:            urgency,
+		Impact:             impact,
+		AssignmentGroup:    s.AssignmentGroup,
+		Category:           s.Category,
+	}
+}
+
+func (s *ServiceNow) incidentURL(incident *serviceNowIncident) string {
+	return s.URL + serviceNowIncidentPath + "/" + url.PathEscape(incident.SysID)
+}
+
+// authorizer returns the function setting the credentials on the requests,
+// fetching an OAuth access token when the client credentials are configured.
+func (s *ServiceNow) authorizer(ctx context.Context) (func(*retryablehttp.Request), error) {
+	if s.ClientID == "" {
+		return func(req *retryablehttp.Request) {
+			req.SetBasicAuth(s.Username, s.Password)
+		}, nil
+	}
+
+	token, err := s.accessToken(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get OAuth access token: %w", err)
+	}
+	return func(req *retryablehttp.Request) {
+		token.SetAuthHeader(req.Request)
+	}, nil
+}
+
+// accessToken fetches an OAuth access token with the client credentials,
+// reusing the cached token of the Provider until it expires.
+func (s *ServiceNow) accessToken(ctx context.Context) (*oauth2.Token, error) {
+	newToken := func(ctx context.Context) (cache.Token, error) {
+		config := clientcredentials.Config{
+			ClientID:     s.ClientID,
+			ClientSecret: s.ClientSecret,
+			TokenURL:     s.URL + serviceNowTokenPath,
+			AuthStyle:    oauth2.AuthStyleInParams,
+		}
+		token, err := config.Token(context.WithValue(ctx, oauth2.HTTPClient, s.Client.StandardClient()))
+		if err != nil {
+			return nil, err
+		}
+		return &serviceNowToken{token}, nil
+	}
+
+	if s.tokenCache == nil {
+		token, err := newToken(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return token.(*serviceNowToken).Token, nil
+	}
+
+	key := fmt.Sprintf("servicenow/%x", sha256.Sum256([]byte(s.URL+"\n"+s.ClientID+"\n"+s.ClientSecret)))
+	token, _, err := s.tokenCache.GetOrSet(ctx, key, newToken,
+		cache.WithInvolvedObject(apiv1beta3.ProviderKind, s.providerName, s.providerNamespace, OperationPost))
+	if err != nil {
+		return nil, err
+	}
+	return token.(*serviceNowToken).Token, nil
+}
+
+// do sends a Table API request, and decodes the result of the response into out if not nil.
+func (s *ServiceNow) do(ctx context.Context, authorize func(*retryablehttp.Request),
+	method, address string, in, out any) error {
+	var body io.Reader
+	if in != nil {
+		data, err := json.Marshal(in)
+		if err != nil {
+			return fmt.Errorf("marshalling request payload failed: %w", err)
+		}
+		body = bytes.NewReader(data)
+	}
+
+	req, err := retryablehttp.NewRequestWithContext(ctx, method, address, body)
+	if err != nil {
+		return fmt.Errorf("failed to create a new request: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+	if in != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	authorize(req)
+
+	resp, err := s.Client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if err := validateResponseStatus(resp); err != nil {
+		return fmt.Errorf("request failed: %w", err)
+	}
+
+	if out != nil {
+		result := struct {
+			Result any `json:"result"`
+		}{Result: out}
+		if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+			return fmt.Errorf("failed to decode response body: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// serviceNowDescription renders the event as plain text.
+func serviceNowDescription(ctx context.Context, event eventv1.Event) string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "%s/%s.%s (%s)\n\n%s\n", event.InvolvedObject.Kind, event.InvolvedObject.Name,
+		event.InvolvedObject.Namespace, event.Severity, event.Message)
+
+	keys := make([]string, 0, len(event.Metadata))
+	for k := range event.Metadata {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	for _, k := range keys {
+		fmt.Fprintf(&sb, "\n%s: %s", k, event.Metadata[k])
+	}
+
+	for _, link := range GetLinks(ctx) {
+		fmt.Fprintf(&sb, "\n%s: %s", link.Name, link.URL)
+	}
+
+	return sb.String()
+}
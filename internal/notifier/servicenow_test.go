@@ -0,0 +1,327 @@
+/*
+Copyright 2026 The Flux authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notifier
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+
+	eventv1 "github.com/fluxcd/pkg/apis/event/v1beta1"
+	"github.com/fluxcd/pkg/apis/meta"
+	"github.com/fluxcd/pkg/cache"
+
+	apiv1beta3 "github.com/fluxcd/notification-controller/api/v1beta3"
+)
+
+func TestNewServiceNow(t *testing.T) {
+	tests := []struct {
+		name              string
+		address           string
+		username          string
+		password          string
+		secretData        map[string][]byte
+		opts              *apiv1beta3.ServiceNowOptions
+		expectedErr       string
+		expectedUrgency   string
+		expectedImpact    string
+		expectedCloseCode string
+	}{
+		{
+			name:        "empty address is not allowed",
+			expectedErr: "ServiceNow instance URL (address) cannot be empty",
+		},
+		{
+			name:        "invalid address",
+			address:     "example.service-now.com",
+			expectedErr: "invalid ServiceNow instance URL (address)",
+		},
+		{
+			name:        "empty credentials are not allowed",
+			address:     "https://example.service-now.com",
+			username:    "flux",
+			expectedErr: "invalid credentials, expected to be one of username/password or clientID/clientSecret",
+		},
+		{
+			name:        "client secret is required with client ID",
+			address:     "https://example.service-now.com",
+			secretData:  map[string][]byte{"clientID": []byte("id")},
+			expectedErr: "ServiceNow clientSecret cannot be empty",
+		},
+		{
+			name:              "basic auth with defaults",
+			address:           "https://example.service-now.com/",
+			username:          "flux",
+			password:          "pass",
+			expectedCloseCode: "Resolved by caller",
+		},
+		{
+			name:    "client credentials with options",
+			address: "https://example.service-now.com",
+			secretData: map[string][]byte{
+				"clientID":     []byte("id"),
+				"clientSecret": []byte("secret"),
+			},
+			opts: &apiv1beta3.ServiceNowOptions{
+				Urgency:   "1",
+				Impact:    "3",
+				CloseCode: "Solved (Permanently)",
+			},
+			expectedUrgency:   "1",
+			expectedImpact:    "3",
+			expectedCloseCode: "Solved (Permanently)",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			s, err := NewServiceNow(tt.address, "", nil, "Platform", tt.username, tt.password, tt.secretData, tt.opts, "", "", nil)
+			if tt.expectedErr != "" {
+				g.Expect(err).To(MatchError(ContainSubstring(tt.expectedErr)))
+				g.Expect(s).To(BeNil())
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(s.URL).To(Equal("https://example.service-now.com"))
+			g.Expect(s.Urgency).To(Equal(tt.expectedUrgency))
+			g.Expect(s.Impact).To(Equal(tt.expectedImpact))
+			g.Expect(s.CloseCode).To(Equal(tt.expectedCloseCode))
+		})
+	}
+}
+
+// serviceNowStub is a minimal ServiceNow Table API recording the requests it receives.
+type serviceNowStub struct {
+	activeIncident bool
+	tokenRequests  int
+	query          string
+	created        *serviceNowIncident
+	updated        *serviceNowIncident
+}
+
+func (s *serviceNowStub) handler(g *WithT, authorization string) http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /oauth_token.do", func(w http.ResponseWriter, r *http.Request) {
+		s.tokenRequests++
+		g.Expect(r.ParseForm()).To(Succeed())
+		g.Expect(r.Form.Get("grant_type")).To(Equal("client_credentials"))
+		g.Expect(r.Form.Get("client_id")).To(Equal("id"))
+		g.Expect(r.Form.Get("client_secret")).To(Equal("secret"))
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"access_token":"access-token","token_type":"Bearer","expires_in":1799}`))
+	})
+	mux.HandleFunc("GET /api/now/table/incident", func(w http.ResponseWriter, r *http.Request) {
+		g.Expect(r.Header.Get("Authorization")).To(Equal(authorization))
+		s.query = r.URL.Query().Get("sysparm_query")
+		result := []serviceNowIncident{}
+		if s.activeIncident {
+			result = append(result, serviceNowIncident{SysID: "a1b2", Number: "INC0010001"})
+		}
+		json.NewEncoder(w).Encode(map[string]any{"result": result})
+	})
+	mux.HandleFunc("POST /api/now/table/incident", func(w http.ResponseWriter, r *http.Request) {
+		g.Expect(r.Header.Get("Authorization")).To(Equal(authorization))
+		s.created = &serviceNowIncident{}
+		g.Expect(json.NewDecoder(r.Body).Decode(s.created)).To(Succeed())
+		w.WriteHeader(http.StatusCreated)
+		json.NewEncoder(w).Encode(map[string]any{"result": serviceNowIncident{SysID: "a1b2", Number: "INC0010001"}})
+	})
+	mux.HandleFunc("PATCH /api/now/table/incident/a1b2", func(w http.ResponseWriter, r *http.Request) {
+		g.Expect(r.Header.Get("Authorization")).To(Equal(authorization))
+		s.updated = &serviceNowIncident{}
+		g.Expect(json.NewDecoder(r.Body).Decode(s.updated)).To(Succeed())
+		json.NewEncoder(w).Encode(map[string]any{"result": serviceNowIncident{SysID: "a1b2", Number: "INC0010001"}})
+	})
+	return mux
+}
+
+func TestServiceNow_Post(t *testing.T) {
+	failure := eventv1.Event{
+		InvolvedObject: corev1.ObjectReference{
+			Kind:      "HelmRelease",
+			Namespace: "apps",
+			Name:      "podinfo",
+			UID:       "8a5c9b1e-2d4f-4e6a-9b7c-1d2e3f4a5b6c",
+		},
+		Severity: eventv1.EventSeverityError,
+		Reason:   "UpgradeFailed",
+		Message:  "Helm upgrade failed: timed out waiting for the condition",
+		Metadata: map[string]string{"revision": "6.5.0"},
+	}
+	recovery := failure
+	recovery.Severity = eventv1.EventSeverityInfo
+	recovery.Reason = "UpgradeSucceeded"
+	recovery.Message = "Helm upgrade succeeded"
+	progressing := failure
+	progressing.Severity = eventv1.EventSeverityInfo
+	progressing.Reason = meta.ProgressingReason
+	dependencyNotReady := failure
+	dependencyNotReady.Severity = eventv1.EventSeverityInfo
+	dependencyNotReady.Reason = meta.DependencyNotReadyReason
+
+	tests := []struct {
+		name           string
+		event          eventv1.Event
+		activeIncident bool
+		expectCreate   bool
+		expectWorkNote bool
+		expectResolve  bool
+	}{
+		{
+			name:         "failure creates an incident",
+			event:        failure,
+			expectCreate: true,
+		},
+		{
+			name:           "repeated failure adds a work note",
+			event:          failure,
+			activeIncident: true,
+			expectWorkNote: true,
+		},
+		{
+			name:           "recovery resolves the active incident",
+			event:          recovery,
+			activeIncident: true,
+			expectResolve:  true,
+		},
+		{
+			name:  "recovery without active incident is a no-op",
+			event: recovery,
+		},
+		{
+			name:           "progressing events are skipped",
+			event:          progressing,
+			activeIncident: true,
+		},
+		{
+			name:           "info events other than recoveries leave the active incident",
+			event:          dependencyNotReady,
+			activeIncident: true,
+		},
+	}
+
+	for _, auth := range []string{"basic", "oauth"} {
+		for _, tt := range tests {
+			t.Run(auth+"/"+tt.name, func(t *testing.T) {
+				g := NewWithT(t)
+
+				username, password := "flux", "pass"
+				authorization := "Basic " + basicAuth(username, password)
+				secretData := map[string][]byte{}
+				if auth == "oauth" {
+					username, password = "", ""
+					authorization = "Bearer access-token"
+					secretData["clientID"] = []byte("id")
+					secretData["clientSecret"] = []byte("secret")
+				}
+
+				stub := &serviceNowStub{activeIncident: tt.activeIncident}
+				ts := httptest.NewServer(stub.handler(g, authorization))
+				defer ts.Close()
+
+				opts := &apiv1beta3.ServiceNowOptions{Category: "Software"}
+				s, err := NewServiceNow(ts.URL, "", nil, "Platform", username, password, secretData, opts, "", "", nil)
+				g.Expect(err).ToNot(HaveOccurred())
+				g.Expect(s.Post(context.TODO(), tt.event)).To(Succeed())
+
+				if tt.event.Severity == eventv1.EventSeverityError || IsRecoveryEvent(&tt.event) {
+					g.Expect(stub.query).To(Equal("correlation_id=8a5c9b1e-2d4f-4e6a-9b7c-1d2e3f4a5b6c^stateNOT IN6,7,8^ORDERBYDESCsys_created_on"))
+				}
+
+				g.Expect(stub.created != nil).To(Equal(tt.expectCreate))
+				if tt.expectCreate {
+					g.Expect(stub.created.ShortDescription).To(Equal("HelmRelease/podinfo.apps: UpgradeFailed"))
+					g.Expect(stub.created.Description).To(ContainSubstring(failure.Message))
+					g.Expect(stub.created.Description).To(ContainSubstring("revision: 6.5.0"))
+					g.Expect(stub.created.CorrelationID).To(Equal("8a5c9b1e-2d4f-4e6a-9b7c-1d2e3f4a5b6c"))
+					g.Expect(stub.created.Urgency).To(Equal("1"))
+					g.Expect(stub.created.Impact).To(Equal("2"))
+					g.Expect(stub.created.AssignmentGroup).To(Equal("Platform"))
+					g.Expect(stub.created.Category).To(Equal("Software"))
+				}
+
+				g.Expect(stub.updated != nil).To(Equal(tt.expectWorkNote || tt.expectResolve))
+				if tt.expectWorkNote {
+					g.Expect(stub.updated.WorkNotes).To(ContainSubstring(failure.Message))
+					g.Expect(stub.updated.State).To(BeEmpty())
+				}
+				if tt.expectResolve {
+					g.Expect(stub.updated.State).To(Equal("6"))
+					g.Expect(stub.updated.CloseCode).To(Equal("Resolved by caller"))
+					g.Expect(stub.updated.CloseNotes).To(ContainSubstring(recovery.Message))
+				}
+			})
+		}
+	}
+}
+
+func TestServiceNow_PostOverrides(t *testing.T) {
+	g := NewWithT(t)
+
+	stub := &serviceNowStub{}
+	ts := httptest.NewServer(stub.handler(g, "Basic "+basicAuth("flux", "pass")))
+	defer ts.Close()
+
+	opts := &apiv1beta3.ServiceNowOptions{Urgency: "3", Impact: "1"}
+	s, err := NewServiceNow(ts.URL, "", nil, "Platform", "flux", "pass", nil, opts, "", "", nil)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	event := eventv1.Event{
+		InvolvedObject: corev1.ObjectReference{Kind: "Kustomization", Namespace: "flux-system", Name: "apps"},
+		Severity:       eventv1.EventSeverityError,
+		Reason:         "ReconciliationFailed",
+		Message:        "kustomize build failed",
+	}
+	g.Expect(s.Post(context.TODO(), event)).To(Succeed())
+	g.Expect(stub.created).ToNot(BeNil())
+	g.Expect(stub.created.Urgency).To(Equal("3"))
+	g.Expect(stub.created.Impact).To(Equal("1"))
+}
+
+func TestServiceNow_PostCachesToken(t *testing.T) {
+	g := NewWithT(t)
+
+	stub := &serviceNowStub{}
+	ts := httptest.NewServer(stub.handler(g, "Bearer access-token"))
+	defer ts.Close()
+
+	tokenCache, err := cache.NewTokenCache(1)
+	g.Expect(err).ToNot(HaveOccurred())
+	secretData := map[string][]byte{
+		"clientID":     []byte("id"),
+		"clientSecret": []byte("secret"),
+	}
+	s, err := NewServiceNow(ts.URL, "", nil, "", "", "", secretData, nil, "servicenow", "flux-system", tokenCache)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	event := eventv1.Event{
+		InvolvedObject: corev1.ObjectReference{Kind: "Kustomization", Namespace: "flux-system", Name: "apps"},
+		Severity:       eventv1.EventSeverityInfo,
+		Reason:         "ReconciliationSucceeded",
+		Message:        "Applied revision: main@sha1:a1b2c3",
+	}
+	g.Expect(s.Post(context.TODO(), event)).To(Succeed())
+	g.Expect(s.Post(context.TODO(), event)).To(Succeed())
+	g.Expect(stub.tokenRequests).To(Equal(1))
+}
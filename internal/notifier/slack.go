@@ -20,6 +20,7 @@ import (
 	"context"
 	"crypto/tls"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/url"
@@ -27,16 +28,26 @@ import (
 
 	eventv1 "github.com/fluxcd/pkg/apis/event/v1beta1"
 	"github.com/hashicorp/go-retryablehttp"
+
+	apiv1beta3 "github.com/fluxcd/notification-controller/api/v1beta3"
+)
+
+const (
+	slackPostMessagePath = "/api/chat.postMessage"
+	slackUpdatePath      = "/api/chat.update"
 )
 
 // Slack holds the hook URL
 type Slack struct {
-	URL       string
-	ProxyURL  string
-	Token     string
-	Username  string
-	Channel   string
-	TLSConfig *tls.Config
+	URL          string
+	ProxyURL     string
+	Token        string
+	Username     string
+	Channel      string
+	TLSConfig    *tls.Config
+	ProviderUID  string
+	Threads      bool
+	UpdateParent bool
 }
 
 // SlackPayload holds the channel and attachments
@@ -46,17 +57,36 @@ type SlackPayload struct {
 	IconUrl     string            `json:"icon_url"`
 	IconEmoji   string            `json:"icon_emoji"`
 	Text        string            `json:"text,omitempty"`
+	ThreadTS    string            `json:"thread_ts,omitempty"`
 	Attachments []SlackAttachment `json:"attachments,omitempty"`
 }
 
+// SlackUpdatePayload holds the message updated with chat.update
+type SlackUpdatePayload struct {
+	Channel     string            `json:"channel"`
+	TS          string            `json:"ts"`
+	Text        string            `json:"text"`
+	Attachments []SlackAttachment `json:"attachments"`
+}
+
+// slackAPIResponse holds the response of the chat.postMessage and
+// chat.update API methods
+type slackAPIResponse struct {
+	Ok      bool   `json:"ok"`
+	Error   string `json:"error"`
+	Channel string `json:"channel"`
+	TS      string `json:"ts"`
+}
+
 // SlackAttachment holds the markdown message body
 type SlackAttachment struct {
-	Fallback   string       `json:"fallback"`
-	Color      string       `json:"color"`
-	AuthorName string       `json:"author_name"`
-	Text       string       `json:"text"`
-	MrkdwnIn   []string     `json:"mrkdwn_in"`
-	Fields     []SlackField `json:"fields"`
+	Fallback   string        `json:"fallback"`
+	Color      string        `json:"color"`
+	AuthorName string        `json:"author_name"`
+	Text       string        `json:"text"`
+	MrkdwnIn   []string      `json:"mrkdwn_in"`
+	Fields     []SlackField  `json:"fields"`
+	Actions    []SlackAction `json:"actions,omitempty"`
 }
 
 type SlackField struct {
@@ -65,21 +95,46 @@ type SlackField struct {
 	Short bool   `json:"short"`
 }
 
-// NewSlack validates the Slack URL and returns a Slack object
-func NewSlack(hookURL string, proxyURL string, token string, tlsConfig *tls.Config, username string, channel string) (*Slack, error) {
-	_, err := url.ParseRequestURI(hookURL)
+// SlackAction holds a link button of the attachment
+type SlackAction struct {
+	Type string `json:"type"`
+	Text string `json:"text"`
+	URL  string `json:"url"`
+}
+
+// NewSlack validates the Slack URL and returns a Slack object.
+// When using the chat.postMessage API, the threads option groups the messages
+// of an involved object in a thread, and the updateParent option updates the
+// first message of the thread with the state of the latest event.
+func NewSlack(hookURL string, proxyURL string, token string, tlsConfig *tls.Config, username string, channel string,
+	providerUID string, opts *apiv1beta3.SlackOptions) (*Slack, error) {
+	u, err := url.ParseRequestURI(hookURL)
 	if err != nil {
 		return nil, fmt.Errorf("invalid Slack hook URL %s: '%w'", hookURL, err)
 	}
 
-	return &Slack{
-		Channel:   channel,
-		Username:  username,
-		URL:       hookURL,
-		ProxyURL:  proxyURL,
-		Token:     token,
-		TLSConfig: tlsConfig,
-	}, nil
+	s := &Slack{
+		Channel:     channel,
+		Username:    username,
+		URL:         hookURL,
+		ProxyURL:    proxyURL,
+		Token:       token,
+		TLSConfig:   tlsConfig,
+		ProviderUID: providerUID,
+	}
+
+	if opts != nil {
+		s.Threads = opts.Threads
+		s.UpdateParent = opts.UpdateParent
+	}
+	if (s.Threads || s.UpdateParent) && !strings.HasSuffix(u.Path, slackPostMessagePath) {
+		return nil, fmt.Errorf("Slack threads require the chat.postMessage API address, got %s", hookURL)
+	}
+	if s.UpdateParent && !s.Threads {
+		return nil, errors.New("Slack updateParent requires threads to be enabled")
+	}
+
+	return s, nil
 }
 
 // Post Slack message
@@ -114,10 +169,69 @@ func (s *Slack) Post(ctx context.Context, event eventv1.Event) error {
 		Text:       event.Message,
 		MrkdwnIn:   []string{"text"},
 		Fields:     sfields,
+		Actions:    slackActions(GetLinks(ctx)),
 	}
 
 	payload.Attachments = []SlackAttachment{a}
 
+	if s.Threads {
+		return s.postThread(ctx, event, payload)
+	}
+
+	opts := s.postOptions()
+	if s.URL == "https://slack.com/api/chat.postMessage" {
+		opts = append(opts, withResponseValidator(validateSlackResponse))
+	}
+
+	if err := postMessage(ctx, s.URL, payload, opts...); err != nil {
+		return fmt.Errorf("postMessage failed: %w", err)
+	}
+
+	return nil
+}
+
+// postThread posts the first message of an involved object as the parent
+// of a thread, and the following messages as replies to that thread.
+// The parent message is updated with the latest state if enabled.
+func (s *Slack) postThread(ctx context.Context, event eventv1.Event, payload SlackPayload) error {
+	threadTS := getThread(s.ProviderUID, s.Channel, event)
+	header := slackThreadHeader(event)
+	if s.UpdateParent && threadTS == "" {
+		payload.Text = header
+	}
+	payload.ThreadTS = threadTS
+
+	var resp slackAPIResponse
+	opts := append(s.postOptions(), withResponseValidator(slackResponseValidator(&resp)))
+	if err := postMessage(ctx, s.URL, payload, opts...); err != nil {
+		return fmt.Errorf("postMessage failed: %w", err)
+	}
+
+	if threadTS == "" {
+		if resp.TS != "" {
+			setThread(s.ProviderUID, s.Channel, event, resp.TS)
+		}
+		return nil
+	}
+
+	if s.UpdateParent {
+		update := SlackUpdatePayload{
+			Channel:     resp.Channel,
+			TS:          threadTS,
+			Text:        header,
+			Attachments: payload.Attachments,
+		}
+		address := strings.TrimSuffix(s.URL, slackPostMessagePath) + slackUpdatePath
+		opts := append(s.postOptions(), withResponseValidator(validateSlackResponse))
+		if err := postMessage(ctx, address, update, opts...); err != nil {
+			return fmt.Errorf("failed to update thread parent message: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (s *Slack) postOptions() []postOption {
 	opts := []postOption{
 		withRequestModifier(func(request *retryablehttp.Request) {
 			if s.Token != "" {
@@ -131,15 +245,31 @@ func (s *Slack) Post(ctx context.Context, event eventv1.Event) error {
 	if s.TLSConfig != nil {
 		opts = append(opts, withTLSConfig(s.TLSConfig))
 	}
-	if s.URL == "https://slack.com/api/chat.postMessage" {
-		opts = append(opts, withResponseValidator(validateSlackResponse))
-	}
+	return opts
+}
 
-	if err := postMessage(ctx, s.URL, payload, opts...); err != nil {
-		return fmt.Errorf("postMessage failed: %w", err)
+// slackThreadHeader returns the text of the thread parent message,
+// showing the state of the involved object after the event.
+func slackThreadHeader(event eventv1.Event) string {
+	status := ":white_check_mark:"
+	if event.Severity == eventv1.EventSeverityError {
+		status = ":x:"
 	}
+	return fmt.Sprintf("%s *%s/%s.%s* %s", status, strings.ToLower(event.InvolvedObject.Kind),
+		event.InvolvedObject.Name, event.InvolvedObject.Namespace, event.Reason)
+}
 
-	return nil
+// slackActions returns the link buttons of the given links.
+func slackActions(links []Link) []SlackAction {
+	actions := make([]SlackAction, 0, len(links))
+	for _, l := range links {
+		actions = append(actions, SlackAction{
+			Type: "button",
+			Text: l.Name,
+			URL:  l.URL,
+		})
+	}
+	return actions
 }
 
 // validateSlackResponse validates that a chat.postMessage API response is successful.
@@ -149,17 +279,20 @@ func (s *Slack) Post(ctx context.Context, event eventv1.Event) error {
 // On the other hand, incoming webhooks return more expressive HTTP status codes.
 // See https://api.slack.com/messaging/webhooks#handling_errors.
 func validateSlackResponse(resp *http.Response) error {
-	var slackResp struct {
-		Ok    bool   `json:"ok"`
-		Error string `json:"error"`
-	}
+	return slackResponseValidator(&slackAPIResponse{})(resp)
+}
 
-	if err := json.NewDecoder(resp.Body).Decode(&slackResp); err != nil {
-		return fmt.Errorf("unable to unmarshal response body: %w", err)
-	}
+// slackResponseValidator returns a response validator that decodes the
+// API response into slackResp, e.g. to read the ts of the posted message.
+func slackResponseValidator(slackResp *slackAPIResponse) func(resp *http.Response) error {
+	return func(resp *http.Response) error {
+		if err := json.NewDecoder(resp.Body).Decode(slackResp); err != nil {
+			return fmt.Errorf("unable to unmarshal response body: %w", err)
+		}
 
-	if slackResp.Ok {
-		return nil
+		if slackResp.Ok {
+			return nil
+		}
+		return fmt.Errorf("Slack responded with error: %s", slackResp.Error)
 	}
-	return fmt.Errorf("Slack responded with error: %s", slackResp.Error)
 }
@@ -19,14 +19,71 @@ package notifier
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"io"
 	"net/http"
 	"net/http/httptest"
 	"testing"
 
 	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+
+	eventv1 "github.com/fluxcd/pkg/apis/event/v1beta1"
+
+	apiv1beta3 "github.com/fluxcd/notification-controller/api/v1beta3"
 )
 
+func TestNewSlack(t *testing.T) {
+	tests := []struct {
+		name                 string
+		address              string
+		opts                 *apiv1beta3.SlackOptions
+		expectedErr          string
+		expectedThreads      bool
+		expectedUpdateParent bool
+	}{
+		{
+			name:    "incoming webhook",
+			address: "https://hooks.slack.com/services/YOUR/SLACK/WEBHOOK",
+		},
+		{
+			name:                 "threads with updated parent",
+			address:              "https://slack.com/api/chat.postMessage",
+			opts:                 &apiv1beta3.SlackOptions{Threads: true, UpdateParent: true},
+			expectedThreads:      true,
+			expectedUpdateParent: true,
+		},
+		{
+			name:        "threads require the API address",
+			address:     "https://hooks.slack.com/services/YOUR/SLACK/WEBHOOK",
+			opts:        &apiv1beta3.SlackOptions{Threads: true},
+			expectedErr: "Slack threads require the chat.postMessage API address",
+		},
+		{
+			name:        "updateParent requires threads",
+			address:     "https://slack.com/api/chat.postMessage",
+			opts:        &apiv1beta3.SlackOptions{UpdateParent: true},
+			expectedErr: "Slack updateParent requires threads to be enabled",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			slack, err := NewSlack(tt.address, "", "xoxb-token", nil, "", "general", "uid", tt.opts)
+			if tt.expectedErr != "" {
+				g.Expect(err).To(MatchError(ContainSubstring(tt.expectedErr)))
+				g.Expect(slack).To(BeNil())
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(slack.Threads).To(Equal(tt.expectedThreads))
+			g.Expect(slack.UpdateParent).To(Equal(tt.expectedUpdateParent))
+		})
+	}
+}
+
 func TestSlack_Post(t *testing.T) {
 	g := NewWithT(t)
 	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -41,13 +98,36 @@ func TestSlack_Post(t *testing.T) {
 	}))
 	defer ts.Close()
 
-	slack, err := NewSlack(ts.URL, "", "", nil, "", "test")
+	slack, err := NewSlack(ts.URL, "", "", nil, "", "test", "", nil)
 	g.Expect(err).ToNot(HaveOccurred())
 
 	err = slack.Post(context.TODO(), testEvent())
 	g.Expect(err).ToNot(HaveOccurred())
 }
 
+func TestSlack_PostLinks(t *testing.T) {
+	g := NewWithT(t)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, err := io.ReadAll(r.Body)
+		g.Expect(err).ToNot(HaveOccurred())
+
+		var payload = SlackPayload{}
+		err = json.Unmarshal(b, &payload)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(payload.Attachments[0].Actions).To(Equal([]SlackAction{
+			{Type: "button", Text: "Dashboard", URL: "https://dashboard.example.com"},
+		}))
+	}))
+	defer ts.Close()
+
+	slack, err := NewSlack(ts.URL, "", "", nil, "", "test", "", nil)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	ctx := WithLinks(context.TODO(), []Link{{Name: "Dashboard", URL: "https://dashboard.example.com"}})
+	err = slack.Post(ctx, testEvent())
+	g.Expect(err).ToNot(HaveOccurred())
+}
+
 func TestSlack_ValidateResponse(t *testing.T) {
 	g := NewWithT(t)
 
@@ -66,3 +146,83 @@ func TestSlack_ValidateResponse(t *testing.T) {
 	err = validateSlackResponse(resp.Result())
 	g.Expect(err).To(MatchError(ContainSubstring("Slack responded with error: too_many_attachments")))
 }
+
+func TestSlack_PostThreads(t *testing.T) {
+	event := eventv1.Event{
+		InvolvedObject: corev1.ObjectReference{
+			Kind:      "Kustomization",
+			Namespace: "flux-system",
+			Name:      "apps",
+			UID:       "5d0a4c5e-2b7f-4f8e-a1c3-9e8d7c6b5a40",
+		},
+		Severity: eventv1.EventSeverityInfo,
+		Reason:   "ReconciliationSucceeded",
+		Message:  "Reconciliation finished",
+	}
+	failed := event
+	failed.Severity = eventv1.EventSeverityError
+	failed.Reason = "HealthCheckFailed"
+	failed.Message = "Health check failed"
+
+	tests := []struct {
+		name         string
+		updateParent bool
+	}{
+		{
+			name: "replies in thread",
+		},
+		{
+			name:         "replies in thread and updates parent",
+			updateParent: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			var posts []SlackPayload
+			var updates []SlackUpdatePayload
+			mux := http.NewServeMux()
+			mux.HandleFunc("/api/chat.postMessage", func(w http.ResponseWriter, r *http.Request) {
+				g.Expect(r.Header.Get("Authorization")).To(Equal("Bearer xoxb-token"))
+				var payload SlackPayload
+				g.Expect(json.NewDecoder(r.Body).Decode(&payload)).To(Succeed())
+				posts = append(posts, payload)
+				fmt.Fprintf(w, `{"ok":true,"channel":"C0123","ts":"1792324800.00000%d"}`, len(posts))
+			})
+			mux.HandleFunc("/api/chat.update", func(w http.ResponseWriter, r *http.Request) {
+				var payload SlackUpdatePayload
+				g.Expect(json.NewDecoder(r.Body).Decode(&payload)).To(Succeed())
+				updates = append(updates, payload)
+				_, _ = w.Write([]byte(`{"ok":true}`))
+			})
+			ts := httptest.NewServer(mux)
+			defer ts.Close()
+
+			opts := &apiv1beta3.SlackOptions{Threads: true, UpdateParent: tt.updateParent}
+			slack, err := NewSlack(ts.URL+"/api/chat.postMessage", "", "xoxb-token", nil, "", "general", tt.name, opts)
+			g.Expect(err).ToNot(HaveOccurred())
+
+			g.Expect(slack.Post(context.TODO(), event)).To(Succeed())
+			g.Expect(slack.Post(context.TODO(), failed)).To(Succeed())
+
+			g.Expect(posts).To(HaveLen(2))
+			g.Expect(posts[0].ThreadTS).To(BeEmpty())
+			g.Expect(posts[1].ThreadTS).To(Equal("1792324800.000001"))
+			g.Expect(posts[1].Attachments[0].Color).To(Equal("danger"))
+
+			if !tt.updateParent {
+				g.Expect(posts[0].Text).To(BeEmpty())
+				g.Expect(updates).To(BeEmpty())
+				return
+			}
+			g.Expect(posts[0].Text).To(Equal(":white_check_mark: *kustomization/apps.flux-system* ReconciliationSucceeded"))
+			g.Expect(updates).To(HaveLen(1))
+			g.Expect(updates[0].Channel).To(Equal("C0123"))
+			g.Expect(updates[0].TS).To(Equal("1792324800.000001"))
+			g.Expect(updates[0].Text).To(Equal(":x: *kustomization/apps.flux-system* HealthCheckFailed"))
+			g.Expect(updates[0].Attachments[0].Text).To(Equal("Health check failed"))
+		})
+	}
+}
@@ -0,0 +1,160 @@
+/*
+Copyright 2026 The Flux authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notifier
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	eventv1 "github.com/fluxcd/pkg/apis/event/v1beta1"
+	"github.com/hashicorp/go-retryablehttp"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	apiv1beta3 "github.com/fluxcd/notification-controller/api/v1beta3"
+)
+
+const (
+	// splunkHECEventPath is the path of the HEC endpoint accepting JSON events.
+	splunkHECEventPath = "/services/collector/event"
+	// splunkHECDefaultSource is the source of the events when spec.splunkhec.source is not set.
+	splunkHECDefaultSource = "notification-controller"
+	// splunkHECDefaultSourceType is the sourcetype of the events when spec.splunkhec.sourcetype is not set.
+	splunkHECDefaultSourceType = "flux:event"
+)
+
+// SplunkHEC holds the HTTP Event Collector endpoint and the metadata of the indexed events.
+type SplunkHEC struct {
+	URL        string
+	ProxyURL   string
+	TLSConfig  *tls.Config
+	Token      string
+	Index      string
+	Source     string
+	SourceType string
+	batchKey   string
+	batch      batchOptions
+}
+
+// splunkHECEvent is the HEC representation of a Flux event.
+type splunkHECEvent struct {
+	Time       float64           `json:"time"`
+	Index      string            `json:"index,omitempty"`
+	Source     string            `json:"source,omitempty"`
+	SourceType string            `json:"sourcetype,omitempty"`
+	Fields     map[string]string `json:"fields,omitempty"`
+	Event      eventv1.Event     `json:"event"`
+}
+
+// NewSplunkHEC creates a new Splunk HTTP Event Collector notifier.
+//
+// Parameters:
+//   - providerUID: the UID of the Provider, the events of a Provider are sent in batches
+//   - address: HEC URL (e.g., "https://splunk:8088"), the event endpoint is used when the path is empty
+//   - proxyURL: HTTP/S proxy URL (optional)
+//   - tlsConfig: TLS configuration (optional)
+//   - index: the index of the events, the default index of the token is used when empty
+//   - token: HEC token
+//   - batch: the size and flush interval of the batches (optional)
+//   - opts: the source and sourcetype of the events (optional)
+//
+// Returns an error if the address is invalid or the token is empty.
+func NewSplunkHEC(providerUID string, address string, proxyURL string, tlsConfig *tls.Config,
+	index string, token string, batch *apiv1beta3.BatchOptions, opts *apiv1beta3.SplunkHECOptions) (*SplunkHEC, error) {
+	u, err := url.ParseRequestURI(address)
+	if err != nil {
+		return nil, fmt.Errorf("invalid Splunk HEC URL (address) %q: %w", address, err)
+	}
+	if u.Path == "" || u.Path == "/" {
+		u.Path = splunkHECEventPath
+	}
+
+	if token == "" {
+		return nil, errors.New("Splunk HEC token cannot be empty")
+	}
+
+	s := &SplunkHEC{
+		URL:        u.String(),
+		ProxyURL:   proxyURL,
+		TLSConfig:  tlsConfig,
+		Token:      token,
+		Index:      index,
+		Source:     splunkHECDefaultSource,
+		SourceType: splunkHECDefaultSourceType,
+		batchKey:   "splunkhec/" + providerUID,
+		batch:      newBatchOptions(batch),
+	}
+	if opts != nil && opts.Source != "" {
+		s.Source = opts.Source
+	}
+	if opts != nil && opts.SourceType != "" {
+		s.SourceType = opts.SourceType
+	}
+
+	return s, nil
+}
+
+// Post adds the event to the batch of the provider, and returns once the batch is sent to Splunk.
+func (s *SplunkHEC) Post(ctx context.Context, event eventv1.Event) error {
+	timestamp := event.Timestamp.Time
+	if timestamp.IsZero() {
+		timestamp = time.Now()
+	}
+
+	payload, err := json.Marshal(splunkHECEvent{
+		Time:       float64(timestamp.UnixMilli()) / 1000,
+		Index:      s.Index,
+		Source:     s.Source,
+		SourceType: s.SourceType,
+		Fields: map[string]string{
+			"kind":      event.InvolvedObject.Kind,
+			"namespace": event.InvolvedObject.Namespace,
+			"name":      event.InvolvedObject.Name,
+			"severity":  event.Severity,
+			"reason":    event.Reason,
+		},
+		Event: event,
+	})
+	if err != nil {
+		return fmt.Errorf("error json-marshaling event: %w", err)
+	}
+
+	if err := addToBatch(ctx, s.batchKey, s.batch, s.flush, payload); err != nil {
+		return fmt.Errorf("error sending events to Splunk HEC: %w", err)
+	}
+
+	// debug log
+	log.FromContext(ctx).V(1).Info("Event sent to Splunk HEC", "index", s.Index)
+
+	return nil
+}
+
+// flush sends the batched events in a single request, HEC accepts concatenated JSON events.
+func (s *SplunkHEC) flush(ctx context.Context, items [][]byte) error {
+	return postMessage(ctx, s.URL, bytes.Join(items, []byte("\n")),
+		withProxy(s.ProxyURL),
+		withTLSConfig(s.TLSConfig),
+		withContentType("application/json"),
+		withRequestModifier(func(req *retryablehttp.Request) {
+			req.Header.Set("Authorization", "Splunk "+s.Token)
+		}))
+}
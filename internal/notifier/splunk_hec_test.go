@@ -0,0 +1,153 @@
+/*
+Copyright 2026 The Flux authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notifier
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"k8s.io/utils/ptr"
+
+	eventv1 "github.com/fluxcd/pkg/apis/event/v1beta1"
+
+	apiv1beta3 "github.com/fluxcd/notification-controller/api/v1beta3"
+)
+
+func TestNewSplunkHEC(t *testing.T) {
+	tests := []struct {
+		name               string
+		address            string
+		token              string
+		opts               *apiv1beta3.SplunkHECOptions
+		expectedErr        string
+		expectedURL        string
+		expectedSourceType string
+	}{
+		{
+			name:        "invalid address",
+			address:     "splunk",
+			token:       "token",
+			expectedErr: "invalid Splunk HEC URL (address)",
+		},
+		{
+			name:        "empty token is not allowed",
+			address:     "https://splunk:8088",
+			expectedErr: "Splunk HEC token cannot be empty",
+		},
+		{
+			name:               "event endpoint by default",
+			address:            "https://splunk:8088",
+			token:              "token",
+			expectedURL:        "https://splunk:8088/services/collector/event",
+			expectedSourceType: "flux:event",
+		},
+		{
+			name:               "custom endpoint and sourcetype",
+			address:            "https://http-inputs-example.splunkcloud.com/services/collector",
+			token:              "token",
+			opts:               &apiv1beta3.SplunkHECOptions{SourceType: "fluxcd"},
+			expectedURL:        "https://http-inputs-example.splunkcloud.com/services/collector",
+			expectedSourceType: "fluxcd",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			s, err := NewSplunkHEC("uid", tt.address, "", nil, "flux", tt.token, nil, tt.opts)
+			if tt.expectedErr != "" {
+				g.Expect(err).To(MatchError(ContainSubstring(tt.expectedErr)))
+				g.Expect(s).To(BeNil())
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(s.URL).To(Equal(tt.expectedURL))
+			g.Expect(s.Index).To(Equal("flux"))
+			g.Expect(s.Source).To(Equal("notification-controller"))
+			g.Expect(s.SourceType).To(Equal(tt.expectedSourceType))
+		})
+	}
+}
+
+func TestSplunkHEC_Post(t *testing.T) {
+	g := NewWithT(t)
+
+	var requests int
+	var received []splunkHECEvent
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		g.Expect(r.URL.Path).To(Equal("/services/collector/event"))
+		g.Expect(r.Header.Get("Authorization")).To(Equal("Splunk token"))
+		scanner := bufio.NewScanner(r.Body)
+		for scanner.Scan() {
+			var e splunkHECEvent
+			g.Expect(json.Unmarshal(scanner.Bytes(), &e)).To(Succeed())
+			received = append(received, e)
+		}
+		w.Write([]byte(`{"text":"Success","code":0}`))
+	}))
+	defer ts.Close()
+
+	s, err := NewSplunkHEC(t.Name(), ts.URL, "", nil, "flux", "token", &apiv1beta3.BatchOptions{Size: ptr.To[int32](2)}, nil)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	event := eventv1.Event{
+		InvolvedObject: corev1.ObjectReference{
+			Kind:      "Kustomization",
+			Namespace: "flux-system",
+			Name:      "apps",
+		},
+		Severity:  eventv1.EventSeverityError,
+		Reason:    "ReconciliationFailed",
+		Message:   "kustomize build failed",
+		Timestamp: metav1.NewTime(time.Date(2026, 10, 18, 12, 0, 0, 500000000, time.UTC)),
+	}
+	recovery := event
+	recovery.Severity = eventv1.EventSeverityInfo
+	recovery.Reason = "ReconciliationSucceeded"
+
+	errs := make(chan error, 2)
+	go func() { errs <- s.Post(context.TODO(), event) }()
+	go func() { errs <- s.Post(context.TODO(), recovery) }()
+	g.Expect(<-errs).ToNot(HaveOccurred())
+	g.Expect(<-errs).ToNot(HaveOccurred())
+
+	g.Expect(requests).To(Equal(1))
+	g.Expect(received).To(HaveLen(2))
+	for _, e := range received {
+		g.Expect(e.Time).To(Equal(1792324800.5))
+		g.Expect(e.Index).To(Equal("flux"))
+		g.Expect(e.Source).To(Equal("notification-controller"))
+		g.Expect(e.SourceType).To(Equal("flux:event"))
+		g.Expect(e.Fields).To(HaveKeyWithValue("kind", "Kustomization"))
+		g.Expect(e.Fields).To(HaveKeyWithValue("namespace", "flux-system"))
+		g.Expect(e.Fields).To(HaveKeyWithValue("name", "apps"))
+		g.Expect(e.Event.Message).To(Equal("kustomize build failed"))
+	}
+	g.Expect([]string{received[0].Fields["reason"], received[1].Fields["reason"]}).
+		To(ConsistOf("ReconciliationFailed", "ReconciliationSucceeded"))
+}
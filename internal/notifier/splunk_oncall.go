@@ -0,0 +1,127 @@
+/*
+Copyright 2026 The Flux authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notifier
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net/url"
+
+	eventv1 "github.com/fluxcd/pkg/apis/event/v1beta1"
+	"github.com/fluxcd/pkg/apis/meta"
+)
+
+// SplunkOnCall holds the Splunk On-Call (VictorOps) REST endpoint URL.
+type SplunkOnCall struct {
+	URL       string
+	ProxyURL  string
+	TLSConfig *tls.Config
+}
+
+// SplunkOnCallAlert holds an alert of the Splunk On-Call REST endpoint.
+// See https://help.victorops.com/knowledge-base/rest-endpoint-integration-guide/.
+type SplunkOnCallAlert struct {
+	MessageType         string            `json:"message_type"`
+	EntityID            string            `json:"entity_id"`
+	EntityDisplayName   string            `json:"entity_display_name"`
+	StateMessage        string            `json:"state_message"`
+	StateStartTime      int64             `json:"state_start_time"`
+	MonitoringTool      string            `json:"monitoring_tool"`
+	ReportingController string            `json:"reporting_controller"`
+	Metadata            map[string]string `json:"metadata,omitempty"`
+	Links               map[string]string `json:"links,omitempty"`
+}
+
+// NewSplunkOnCall creates a new Splunk On-Call notifier.
+//
+// Parameters:
+//   - address: REST endpoint URL with the API key
+//     (e.g., "https://alert.victorops.com/integrations/generic/20131114/alert/<api-key>")
+//   - proxyURL: Proxy URL (optional)
+//   - tlsConfig: TLS configuration (optional)
+//   - routingKey: routing key appended to the address (optional)
+//
+// Returns an error if the address is invalid.
+func NewSplunkOnCall(address string, proxyURL string, tlsConfig *tls.Config, routingKey string) (*SplunkOnCall, error) {
+	if _, err := url.ParseRequestURI(address); err != nil {
+		return nil, fmt.Errorf("invalid Splunk On-Call REST endpoint URL (address) %q: %w", address, err)
+	}
+
+	if routingKey != "" {
+		var err error
+		address, err = url.JoinPath(address, routingKey)
+		if err != nil {
+			return nil, fmt.Errorf("failed to append routing key to the address: %w", err)
+		}
+	}
+
+	return &SplunkOnCall{
+		URL:       address,
+		ProxyURL:  proxyURL,
+		TLSConfig: tlsConfig,
+	}, nil
+}
+
+// Post triggers a critical alert for error events, and recovers the alert
+// of the involved object for the other events.
+func (s *SplunkOnCall) Post(ctx context.Context, event eventv1.Event) error {
+	// skip progressing events (we want success or failure)
+	if event.HasReason(meta.ProgressingReason) {
+		return nil
+	}
+
+	var opts []postOption
+	if s.ProxyURL != "" {
+		opts = append(opts, withProxy(s.ProxyURL))
+	}
+	if s.TLSConfig != nil {
+		opts = append(opts, withTLSConfig(s.TLSConfig))
+	}
+
+	if err := postMessage(ctx, s.URL, toSplunkOnCallAlert(event, GetLinks(ctx)), opts...); err != nil {
+		return fmt.Errorf("failed sending alert: %w", err)
+	}
+
+	return nil
+}
+
+func toSplunkOnCallAlert(event eventv1.Event, links []Link) SplunkOnCallAlert {
+	name, desc := formatNameAndDescription(event)
+	// Recover just in case an existing incident is open
+	a := SplunkOnCallAlert{
+		MessageType:         "RECOVERY",
+		EntityID:            involvedObjectID(event),
+		EntityDisplayName:   desc + ": " + name,
+		StateMessage:        event.Message,
+		StateStartTime:      event.Timestamp.Unix(),
+		MonitoringTool:      "Flux",
+		ReportingController: event.ReportingController,
+		Metadata:            event.Metadata,
+	}
+	// Trigger an incident for errors
+	if event.Severity == eventv1.EventSeverityError {
+		a.MessageType = "CRITICAL"
+	}
+	if len(links) > 0 {
+		a.Links = make(map[string]string, len(links))
+		for _, l := range links {
+			a.Links[l.Name] = l.URL
+		}
+	}
+	return a
+}
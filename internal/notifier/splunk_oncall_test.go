@@ -0,0 +1,145 @@
+/*
+Copyright 2026 The Flux authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notifier
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	eventv1 "github.com/fluxcd/pkg/apis/event/v1beta1"
+	"github.com/fluxcd/pkg/apis/meta"
+)
+
+func TestNewSplunkOnCall(t *testing.T) {
+	tests := []struct {
+		name        string
+		address     string
+		routingKey  string
+		expectedErr string
+		expectedURL string
+	}{
+		{
+			name:        "invalid address",
+			address:     "victorops",
+			expectedErr: "invalid Splunk On-Call REST endpoint URL (address)",
+		},
+		{
+			name:        "address with routing key",
+			address:     "https://alert.victorops.com/integrations/generic/20131114/alert/api-key/flux",
+			expectedURL: "https://alert.victorops.com/integrations/generic/20131114/alert/api-key/flux",
+		},
+		{
+			name:        "routing key from channel",
+			address:     "https://alert.victorops.com/integrations/generic/20131114/alert/api-key",
+			routingKey:  "flux",
+			expectedURL: "https://alert.victorops.com/integrations/generic/20131114/alert/api-key/flux",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			s, err := NewSplunkOnCall(tt.address, "", nil, tt.routingKey)
+			if tt.expectedErr != "" {
+				g.Expect(err).To(MatchError(ContainSubstring(tt.expectedErr)))
+				g.Expect(s).To(BeNil())
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(s.URL).To(Equal(tt.expectedURL))
+		})
+	}
+}
+
+func TestSplunkOnCall_Post(t *testing.T) {
+	g := NewWithT(t)
+
+	var alerts []SplunkOnCallAlert
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		g.Expect(r.URL.Path).To(Equal("/alert/api-key/flux"))
+		var alert SplunkOnCallAlert
+		g.Expect(json.NewDecoder(r.Body).Decode(&alert)).To(Succeed())
+		alerts = append(alerts, alert)
+		_, _ = w.Write([]byte(`{"result":"success","entity_id":"id"}`))
+	}))
+	defer ts.Close()
+
+	s, err := NewSplunkOnCall(ts.URL+"/alert/api-key", "", nil, "flux")
+	g.Expect(err).ToNot(HaveOccurred())
+
+	event := testEvent()
+	event.Severity = eventv1.EventSeverityError
+	g.Expect(s.Post(context.TODO(), event)).To(Succeed())
+
+	progressing := testEvent()
+	progressing.Reason = meta.ProgressingReason
+	g.Expect(s.Post(context.TODO(), progressing)).To(Succeed())
+
+	g.Expect(s.Post(context.TODO(), testEvent())).To(Succeed())
+
+	g.Expect(alerts).To(HaveLen(2))
+	g.Expect(alerts[0].MessageType).To(Equal("CRITICAL"))
+	g.Expect(alerts[1].MessageType).To(Equal("RECOVERY"))
+	g.Expect(alerts[1].EntityID).To(Equal(alerts[0].EntityID))
+}
+
+func TestToSplunkOnCallAlert(t *testing.T) {
+	g := NewWithT(t)
+
+	event := eventv1.Event{
+		InvolvedObject: corev1.ObjectReference{
+			Kind:      "Kustomization",
+			Namespace: "flux-system",
+			Name:      "apps",
+			UID:       "3f4a5b6c-7d8e-4f9a-8b1c-2d3e4f5a6b7c",
+		},
+		Severity:            eventv1.EventSeverityError,
+		Reason:              "HealthCheckFailed",
+		Message:             "timeout waiting for Deployment/apps/podinfo",
+		Timestamp:           metav1.NewTime(time.Unix(1792324800, 0)),
+		Metadata:            map[string]string{"revision": "main@sha1:e1f2a3b4"},
+		ReportingController: "kustomize-controller",
+	}
+	links := []Link{{Name: "Dashboard", URL: "https://flux.example.com"}}
+
+	g.Expect(toSplunkOnCallAlert(event, links)).To(Equal(SplunkOnCallAlert{
+		MessageType:         "CRITICAL",
+		EntityID:            "3f4a5b6c-7d8e-4f9a-8b1c-2d3e4f5a6b7c",
+		EntityDisplayName:   "health check failed: kustomization/apps",
+		StateMessage:        "timeout waiting for Deployment/apps/podinfo",
+		StateStartTime:      1792324800,
+		MonitoringTool:      "Flux",
+		ReportingController: "kustomize-controller",
+		Metadata:            map[string]string{"revision": "main@sha1:e1f2a3b4"},
+		Links:               map[string]string{"Dashboard": "https://flux.example.com"},
+	}))
+
+	event.Severity = eventv1.EventSeverityInfo
+	alert := toSplunkOnCallAlert(event, nil)
+	g.Expect(alert.MessageType).To(Equal("RECOVERY"))
+	g.Expect(alert.EntityID).To(Equal("3f4a5b6c-7d8e-4f9a-8b1c-2d3e4f5a6b7c"))
+	g.Expect(alert.Links).To(BeNil())
+}
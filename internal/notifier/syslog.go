@@ -0,0 +1,265 @@
+/*
+Copyright 2026 The Flux authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notifier
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/url"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	eventv1 "github.com/fluxcd/pkg/apis/event/v1beta1"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	apiv1beta3 "github.com/fluxcd/notification-controller/api/v1beta3"
+)
+
+const (
+	// syslogDefaultFacility is the facility used when spec.syslog.facility is not set (local0).
+	syslogDefaultFacility = 16
+
+	// syslogSDID is the SD-ID of the structured data element carrying the
+	// involved object and the metadata. 32473 is the enterprise number
+	// reserved for documentation by RFC 5612.
+	syslogSDID = "flux@32473"
+
+	// syslogTimestampFormat is the RFC 3339 timestamp format with the
+	// maximum precision allowed by RFC 5424.
+	syslogTimestampFormat = "2006-01-02T15:04:05.000000Z07:00"
+)
+
+// syslogFacilities maps the facility keywords to their numerical code.
+var syslogFacilities = map[string]int{
+	"kern": 0, "user": 1, "mail": 2, "daemon": 3, "auth": 4, "syslog": 5, "lpr": 6, "news": 7,
+	"uucp": 8, "cron": 9, "authpriv": 10, "ftp": 11, "ntp": 12, "security": 13, "console": 14,
+	"solaris-cron": 15, "local0": 16, "local1": 17, "local2": 18, "local3": 19, "local4": 20,
+	"local5": 21, "local6": 22, "local7": 23,
+}
+
+// Syslog holds the syslog server address, the transport and the header fields.
+type Syslog struct {
+	Network   string
+	Address   string
+	TLSConfig *tls.Config
+	Facility  int
+	Hostname  string
+	AppName   string
+}
+
+// NewSyslog creates a new syslog notifier.
+//
+// Parameters:
+//   - address: syslog server URL with the transport as scheme, one of
+//     "udp://relay:514", "tcp://relay:601" or "tls://relay:6514"
+//   - tlsConfig: TLS configuration used with the tls transport (optional)
+//   - opts: the facility keyword or code (default "local0"), the hostname overriding the
+//     hostname of the controller, and the APP-NAME overriding the reporting controller (optional)
+//
+// Returns an error if the address or the facility is invalid.
+func NewSyslog(address string, tlsConfig *tls.Config, opts *apiv1beta3.SyslogOptions) (*Syslog, error) {
+	u, err := url.Parse(address)
+	if err != nil {
+		return nil, fmt.Errorf("invalid syslog server URL (address) %q: %w", address, err)
+	}
+	switch u.Scheme {
+	case "udp", "tcp", "tls":
+	default:
+		return nil, fmt.Errorf("invalid syslog server URL (address) %q: scheme must be one of udp, tcp or tls", address)
+	}
+	if u.Port() == "" {
+		return nil, fmt.Errorf("invalid syslog server URL (address) %q: port is required", address)
+	}
+
+	s := &Syslog{
+		Network:   u.Scheme,
+		Address:   u.Host,
+		TLSConfig: tlsConfig,
+		Facility:  syslogDefaultFacility,
+	}
+	if opts != nil {
+		s.Hostname = strings.TrimSpace(opts.Hostname)
+		s.AppName = strings.TrimSpace(opts.AppName)
+	}
+	if s.Hostname == "" {
+		s.Hostname, _ = os.Hostname()
+	}
+
+	if opts != nil && opts.Facility != "" {
+		facility := strings.ToLower(strings.TrimSpace(opts.Facility))
+		code, ok := syslogFacilities[facility]
+		if !ok {
+			code, err = strconv.Atoi(facility)
+			if err != nil || code < 0 || code > 23 {
+				return nil, fmt.Errorf("invalid syslog facility '%s', must be a keyword or a code between 0 and 23", opts.Facility)
+			}
+		}
+		s.Facility = code
+	}
+
+	return s, nil
+}
+
+// Post sends the event to the syslog server as an RFC 5424 message.
+func (s *Syslog) Post(ctx context.Context, event eventv1.Event) error {
+	msg := s.formatMessage(event)
+
+	conn, err := s.dial(ctx)
+	if err != nil {
+		return fmt.Errorf("error connecting to syslog server: %w", err)
+	}
+	defer conn.Close()
+
+	if deadline, ok := ctx.Deadline(); ok {
+		if err := conn.SetDeadline(deadline); err != nil {
+			return err
+		}
+	}
+
+	// Messages are framed with octet counting over stream transports,
+	// as mandated by RFC 5425 and described in RFC 6587.
+	if s.Network != "udp" {
+		msg = strconv.Itoa(len(msg)) + " " + msg
+	}
+	if _, err := conn.Write([]byte(msg)); err != nil {
+		return fmt.Errorf("error sending message to syslog server: %w", err)
+	}
+
+	// debug log
+	log.FromContext(ctx).V(1).Info("Event sent to syslog server", "address", s.Address, "network", s.Network)
+
+	return nil
+}
+
+func (s *Syslog) dial(ctx context.Context) (net.Conn, error) {
+	if s.Network != "tls" {
+		var d net.Dialer
+		return d.DialContext(ctx, s.Network, s.Address)
+	}
+
+	config := &tls.Config{}
+	if s.TLSConfig != nil {
+		config = s.TLSConfig.Clone()
+	}
+	if config.ServerName == "" {
+		host, _, err := net.SplitHostPort(s.Address)
+		if err != nil {
+			return nil, err
+		}
+		config.ServerName = host
+	}
+	d := tls.Dialer{Config: config}
+	return d.DialContext(ctx, "tcp", s.Address)
+}
+
+// formatMessage returns the RFC 5424 message of the event, with the
+// involved object and the metadata in the structured data.
+func (s *Syslog) formatMessage(event eventv1.Event) string {
+	severity := 6 // informational
+	switch event.Severity {
+	case eventv1.EventSeverityError:
+		severity = 3 // error
+	case eventv1.EventSeverityTrace:
+		severity = 7 // debug
+	}
+
+	timestamp := "-"
+	if !event.Timestamp.IsZero() {
+		timestamp = event.Timestamp.UTC().Format(syslogTimestampFormat)
+	}
+
+	appName := s.AppName
+	if appName == "" {
+		appName = event.ReportingController
+	}
+
+	params := map[string]string{
+		"kind":      event.InvolvedObject.Kind,
+		"namespace": event.InvolvedObject.Namespace,
+		"name":      event.InvolvedObject.Name,
+		"severity":  event.Severity,
+	}
+	for k, v := range event.Metadata {
+		name := syslogParamName(k)
+		if _, ok := params[name]; !ok {
+			params[name] = v
+		}
+	}
+	names := make([]string, 0, len(params))
+	for k := range params {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+
+	var sd strings.Builder
+	sd.WriteString("[" + syslogSDID)
+	for _, name := range names {
+		fmt.Fprintf(&sd, ` %s="%s"`, name, syslogParamValue(params[name]))
+	}
+	sd.WriteString("]")
+
+	return fmt.Sprintf("<%d>1 %s %s %s - %s %s %s",
+		s.Facility*8+severity,
+		timestamp,
+		syslogHeaderField(s.Hostname, 255),
+		syslogHeaderField(appName, 48),
+		syslogHeaderField(event.Reason, 32),
+		sd.String(),
+		event.Message)
+}
+
+// syslogHeaderField returns the value as a header field of at most maxLen
+// printable US-ASCII characters, or the NILVALUE if empty.
+func syslogHeaderField(value string, maxLen int) string {
+	field := strings.Map(func(r rune) rune {
+		if r < 33 || r > 126 {
+			return -1
+		}
+		return r
+	}, value)
+	if len(field) > maxLen {
+		field = field[:maxLen]
+	}
+	if field == "" {
+		return "-"
+	}
+	return field
+}
+
+// syslogParamName returns the key as an SD-NAME, replacing the characters
+// not allowed with underscores and truncating it to 32 characters.
+func syslogParamName(key string) string {
+	name := strings.Map(func(r rune) rune {
+		if r < 33 || r > 126 || r == '=' || r == ']' || r == '"' {
+			return '_'
+		}
+		return r
+	}, key)
+	if len(name) > 32 {
+		name = name[:32]
+	}
+	return name
+}
+
+// syslogParamValue escapes the characters of the value not allowed in a PARAM-VALUE.
+func syslogParamValue(value string) string {
+	return strings.NewReplacer(`\`, `\\`, `"`, `\"`, `]`, `\]`).Replace(value)
+}
@@ -0,0 +1,237 @@
+/*
+Copyright 2026 The Flux authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notifier
+
+import (
+	"bufio"
+	"context"
+	"crypto/tls"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	eventv1 "github.com/fluxcd/pkg/apis/event/v1beta1"
+
+	apiv1beta3 "github.com/fluxcd/notification-controller/api/v1beta3"
+)
+
+func TestNewSyslog(t *testing.T) {
+	tests := []struct {
+		name             string
+		address          string
+		opts             *apiv1beta3.SyslogOptions
+		expectedErr      string
+		expectedNetwork  string
+		expectedFacility int
+	}{
+		{
+			name:        "unsupported scheme",
+			address:     "http://relay:514",
+			expectedErr: "scheme must be one of udp, tcp or tls",
+		},
+		{
+			name:        "port is required",
+			address:     "tcp://relay",
+			expectedErr: "port is required",
+		},
+		{
+			name:             "UDP with default facility",
+			address:          "udp://relay:514",
+			expectedNetwork:  "udp",
+			expectedFacility: 16,
+		},
+		{
+			name:             "TLS with facility keyword",
+			address:          "tls://relay:6514",
+			opts:             &apiv1beta3.SyslogOptions{Facility: "Daemon"},
+			expectedNetwork:  "tls",
+			expectedFacility: 3,
+		},
+		{
+			name:             "TCP with facility code",
+			address:          "tcp://relay:601",
+			opts:             &apiv1beta3.SyslogOptions{Facility: "23"},
+			expectedNetwork:  "tcp",
+			expectedFacility: 23,
+		},
+		{
+			name:        "invalid facility",
+			address:     "tcp://relay:601",
+			opts:        &apiv1beta3.SyslogOptions{Facility: "24"},
+			expectedErr: "invalid syslog facility '24'",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			s, err := NewSyslog(tt.address, nil, tt.opts)
+			if tt.expectedErr != "" {
+				g.Expect(err).To(MatchError(ContainSubstring(tt.expectedErr)))
+				g.Expect(s).To(BeNil())
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(s.Network).To(Equal(tt.expectedNetwork))
+			g.Expect(s.Facility).To(Equal(tt.expectedFacility))
+			g.Expect(s.Hostname).ToNot(BeEmpty())
+		})
+	}
+}
+
+func TestSyslog_FormatMessage(t *testing.T) {
+	g := NewWithT(t)
+
+	s, err := NewSyslog("udp://relay:514", nil, &apiv1beta3.SyslogOptions{
+		Facility: "local3",
+		Hostname: "prod cluster",
+	})
+	g.Expect(err).ToNot(HaveOccurred())
+
+	event := eventv1.Event{
+		InvolvedObject: corev1.ObjectReference{
+			Kind:      "Kustomization",
+			Namespace: "flux-system",
+			Name:      "apps",
+		},
+		Severity:  eventv1.EventSeverityError,
+		Reason:    "HealthCheckFailed",
+		Message:   "timeout waiting for Deployment/apps/podinfo",
+		Timestamp: metav1.NewTime(time.Date(2026, 10, 18, 12, 30, 0, 123456789, time.UTC)),
+		Metadata: map[string]string{
+			"revision":  "main@sha1:e1f2a3b4",
+			"a=b c":     `quoted "value" with ] and \`,
+			"namespace": "metadata can't override the involved object",
+		},
+		ReportingController: "kustomize-controller",
+	}
+
+	g.Expect(s.formatMessage(event)).To(Equal(`<155>1 2026-10-18T12:30:00.123456Z prodcluster kustomize-controller - HealthCheckFailed ` +
+		`[flux@32473 a_b_c="quoted \"value\" with \] and \\" kind="Kustomization" name="apps" namespace="flux-system" ` +
+		`revision="main@sha1:e1f2a3b4" severity="error"] timeout waiting for Deployment/apps/podinfo`))
+
+	event.Severity = eventv1.EventSeverityInfo
+	event.Reason = ""
+	event.Timestamp = metav1.Time{}
+	event.Metadata = nil
+	s.AppName = "flux"
+	g.Expect(s.formatMessage(event)).To(Equal(`<158>1 - prodcluster flux - - ` +
+		`[flux@32473 kind="Kustomization" name="apps" namespace="flux-system" severity="info"] ` +
+		`timeout waiting for Deployment/apps/podinfo`))
+}
+
+func TestSyslog_Post(t *testing.T) {
+	event := testEvent()
+	event.Severity = eventv1.EventSeverityError
+
+	t.Run("UDP", func(t *testing.T) {
+		g := NewWithT(t)
+
+		conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+		g.Expect(err).ToNot(HaveOccurred())
+		defer conn.Close()
+
+		s, err := NewSyslog("udp://"+conn.LocalAddr().String(), nil, nil)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(s.Post(context.TODO(), event)).To(Succeed())
+
+		buf := make([]byte, 4096)
+		g.Expect(conn.SetReadDeadline(time.Now().Add(5 * time.Second))).To(Succeed())
+		n, _, err := conn.ReadFrom(buf)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(string(buf[:n])).To(Equal(s.formatMessage(event)))
+	})
+
+	t.Run("TCP", func(t *testing.T) {
+		g := NewWithT(t)
+
+		l, err := net.Listen("tcp", "127.0.0.1:0")
+		g.Expect(err).ToNot(HaveOccurred())
+		defer l.Close()
+
+		s, err := NewSyslog("tcp://"+l.Addr().String(), nil, nil)
+		g.Expect(err).ToNot(HaveOccurred())
+		received := receiveSyslogFrame(l)
+		g.Expect(s.Post(context.TODO(), event)).To(Succeed())
+		g.Expect(<-received).To(Equal(s.formatMessage(event)))
+	})
+
+	t.Run("TLS", func(t *testing.T) {
+		g := NewWithT(t)
+
+		srv := httptest.NewTLSServer(http.NotFoundHandler())
+		defer srv.Close()
+		l, err := tls.Listen("tcp", "127.0.0.1:0", &tls.Config{Certificates: srv.TLS.Certificates})
+		g.Expect(err).ToNot(HaveOccurred())
+		defer l.Close()
+
+		// The test certificate is valid for example.com and 127.0.0.1.
+		tlsConfig := srv.Client().Transport.(*http.Transport).TLSClientConfig
+		s, err := NewSyslog("tls://"+l.Addr().String(), tlsConfig, nil)
+		g.Expect(err).ToNot(HaveOccurred())
+		received := receiveSyslogFrame(l)
+		g.Expect(s.Post(context.TODO(), event)).To(Succeed())
+		g.Expect(<-received).To(Equal(s.formatMessage(event)))
+
+		// The server certificate must be verified.
+		s, err = NewSyslog("tls://"+l.Addr().String(), nil, nil)
+		g.Expect(err).ToNot(HaveOccurred())
+		receiveSyslogFrame(l)
+		g.Expect(s.Post(context.TODO(), event)).To(MatchError(ContainSubstring("certificate")))
+	})
+}
+
+// receiveSyslogFrame accepts a connection and returns the message of
+// the first octet-counted frame received.
+func receiveSyslogFrame(l net.Listener) <-chan string {
+	received := make(chan string, 1)
+	go func() {
+		defer close(received)
+		conn, err := l.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		_ = conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+
+		r := bufio.NewReader(conn)
+		length, err := r.ReadString(' ')
+		if err != nil {
+			return
+		}
+		n, err := strconv.Atoi(strings.TrimSpace(length))
+		if err != nil {
+			return
+		}
+		msg := make([]byte, n)
+		if _, err := io.ReadFull(r, msg); err != nil {
+			return
+		}
+		received <- string(msg)
+	}()
+	return received
+}
@@ -25,6 +25,8 @@ import (
 	"strings"
 
 	eventv1 "github.com/fluxcd/pkg/apis/event/v1beta1"
+
+	apiv1beta3 "github.com/fluxcd/notification-controller/api/v1beta3"
 )
 
 const (
@@ -39,19 +41,33 @@ const (
 
 // MS Teams holds the incoming webhook URL
 type MSTeams struct {
-	URL       string
-	ProxyURL  string
-	Schema    int
-	TLSConfig *tls.Config
+	URL          string
+	ProxyURL     string
+	Schema       int
+	TLSConfig    *tls.Config
+	MetadataKeys []string
 }
 
 // MSTeamsPayload holds the message card data
 type MSTeamsPayload struct {
-	Type       string           `json:"@type"`
-	Context    string           `json:"@context"`
-	ThemeColor string           `json:"themeColor"`
-	Summary    string           `json:"summary"`
-	Sections   []MSTeamsSection `json:"sections"`
+	Type            string                   `json:"@type"`
+	Context         string                   `json:"@context"`
+	ThemeColor      string                   `json:"themeColor"`
+	Summary         string                   `json:"summary"`
+	Sections        []MSTeamsSection         `json:"sections"`
+	PotentialAction []MSTeamsPotentialAction `json:"potentialAction,omitempty"`
+}
+
+// MSTeamsPotentialAction holds a link button of the message card
+type MSTeamsPotentialAction struct {
+	Type    string          `json:"@type"`
+	Name    string          `json:"name"`
+	Targets []MSTeamsTarget `json:"targets"`
+}
+
+type MSTeamsTarget struct {
+	OS  string `json:"os"`
+	URI string `json:"uri"`
 }
 
 // MSTeamsSection holds the canary analysis result
@@ -84,9 +100,30 @@ type msAdaptiveCardContent struct {
 	Type    string                      `json:"type"`
 	Version string                      `json:"version"`
 	Body    []msAdaptiveCardBodyElement `json:"body"`
+	Actions []msAdaptiveCardAction      `json:"actions,omitempty"`
 	MSTeams msAdaptiveCardMSTeams       `json:"msteams"`
 }
 
+// msAdaptiveCardMention holds a mention of a user or a tag, see
+// https://learn.microsoft.com/en-us/microsoftteams/platform/task-modules-and-cards/cards/cards-format#mention-support-within-adaptive-cards
+type msAdaptiveCardMention struct {
+	Type      string                         `json:"type"`
+	Text      string                         `json:"text"`
+	Mentioned msAdaptiveCardMentionedElement `json:"mentioned"`
+}
+
+type msAdaptiveCardMentionedElement struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	Type string `json:"type,omitempty"`
+}
+
+type msAdaptiveCardAction struct {
+	Type  string `json:"type"`
+	Title string `json:"title"`
+	URL   string `json:"url"`
+}
+
 type msAdaptiveCardBodyElement struct {
 	Type string `json:"type"`
 
@@ -96,11 +133,13 @@ type msAdaptiveCardBodyElement struct {
 }
 
 type msAdaptiveCardContainer struct {
+	Style string                      `json:"style,omitempty"`
 	Items []msAdaptiveCardBodyElement `json:"items,omitempty"`
 }
 
 type msAdaptiveCardMSTeams struct {
-	Width string `json:"width,omitempty"`
+	Width    string                  `json:"width,omitempty"`
+	Entities []msAdaptiveCardMention `json:"entities,omitempty"`
 }
 
 type msAdaptiveCardTextBlock struct {
@@ -120,8 +159,9 @@ type msAdaptiveCardFact struct {
 	Value string `json:"value"`
 }
 
-// NewMSTeams validates the MS Teams URL and returns a MSTeams object
-func NewMSTeams(hookURL string, proxyURL string, tlsConfig *tls.Config) (*MSTeams, error) {
+// NewMSTeams validates the MS Teams URL and returns a MSTeams object.
+// The metadataKeys option sets the allowlist of the metadata keys rendered as facts.
+func NewMSTeams(hookURL string, proxyURL string, tlsConfig *tls.Config, opts *apiv1beta3.MSTeamsOptions) (*MSTeams, error) {
 	u, err := url.ParseRequestURI(hookURL)
 	if err != nil {
 		return nil, fmt.Errorf("invalid MS Teams webhook URL %s: '%w'", hookURL, err)
@@ -139,6 +179,15 @@ func NewMSTeams(hookURL string, proxyURL string, tlsConfig *tls.Config) (*MSTeam
 		provider.Schema = msTeamsSchemaDeprecatedConnector
 	}
 
+	if opts != nil && opts.MetadataKeys != nil {
+		provider.MetadataKeys = []string{}
+		for _, key := range opts.MetadataKeys {
+			if key = strings.TrimSpace(key); key != "" {
+				provider.MetadataKeys = append(provider.MetadataKeys, key)
+			}
+		}
+	}
+
 	return provider, nil
 }
 
@@ -146,14 +195,27 @@ func NewMSTeams(hookURL string, proxyURL string, tlsConfig *tls.Config) (*MSTeam
 func (s *MSTeams) Post(ctx context.Context, event eventv1.Event) error {
 	objName := fmt.Sprintf("%s/%s.%s", strings.ToLower(event.InvolvedObject.Kind), event.InvolvedObject.Name, event.InvolvedObject.Namespace)
 
+	links := GetLinks(ctx)
+
+	// Filter the metadata rendered as facts with the allowlist, if set.
+	if s.MetadataKeys != nil {
+		metadata := make(map[string]string, len(s.MetadataKeys))
+		for _, k := range s.MetadataKeys {
+			if v, ok := event.Metadata[k]; ok {
+				metadata[k] = v
+			}
+		}
+		event.Metadata = metadata
+	}
+
 	var payload any
 	switch s.Schema {
 	case msTeamsSchemaDeprecatedConnector:
-		payload = buildMSTeamsDeprecatedConnectorPayload(&event, objName)
+		payload = buildMSTeamsDeprecatedConnectorPayload(&event, objName, links)
 	case msTeamsSchemaAdaptiveCard:
-		payload = buildMSTeamsAdaptiveCardPayload(&event, objName)
+		payload = buildMSTeamsAdaptiveCardPayload(&event, objName, links, GetMentions(ctx))
 	default:
-		payload = buildMSTeamsAdaptiveCardPayload(&event, objName)
+		payload = buildMSTeamsAdaptiveCardPayload(&event, objName, links, GetMentions(ctx))
 	}
 
 	var opts []postOption
@@ -171,7 +233,7 @@ func (s *MSTeams) Post(ctx context.Context, event eventv1.Event) error {
 	return nil
 }
 
-func buildMSTeamsDeprecatedConnectorPayload(event *eventv1.Event, objName string) *MSTeamsPayload {
+func buildMSTeamsDeprecatedConnectorPayload(event *eventv1.Event, objName string, links []Link) *MSTeamsPayload {
 	facts := make([]MSTeamsField, 0, len(event.Metadata))
 	for k, v := range event.Metadata {
 		facts = append(facts, MSTeamsField{
@@ -194,6 +256,14 @@ func buildMSTeamsDeprecatedConnectorPayload(event *eventv1.Event, objName string
 		},
 	}
 
+	for _, l := range links {
+		payload.PotentialAction = append(payload.PotentialAction, MSTeamsPotentialAction{
+			Type:    "OpenUri",
+			Name:    l.Name,
+			Targets: []MSTeamsTarget{{OS: "default", URI: l.URL}},
+		})
+	}
+
 	if event.Severity == eventv1.EventSeverityError {
 		payload.ThemeColor = "FF0000"
 	}
@@ -201,14 +271,20 @@ func buildMSTeamsDeprecatedConnectorPayload(event *eventv1.Event, objName string
 	return payload
 }
 
-func buildMSTeamsAdaptiveCardPayload(event *eventv1.Event, objName string) *msAdaptiveCardMessage {
-	// Prepare message, add red color to error messages.
+func buildMSTeamsAdaptiveCardPayload(event *eventv1.Event, objName string, links []Link,
+	mentions []apiv1beta3.Mention) *msAdaptiveCardMessage {
+	// Prepare message, add red color and style to error messages.
 	message := &msAdaptiveCardTextBlock{
 		Text: event.Message,
 		Wrap: true,
 	}
-	if event.Severity == eventv1.EventSeverityError {
+	style := "good"
+	switch event.Severity {
+	case eventv1.EventSeverityError:
 		message.Color = "attention"
+		style = "attention"
+	case eventv1.EventSeverityTrace:
+		style = "default"
 	}
 
 	// Put "summary" first, then sort the rest of the metadata by key.
@@ -234,6 +310,66 @@ func buildMSTeamsAdaptiveCardPayload(event *eventv1.Event, objName string) *msAd
 		return strings.Compare(a.Title, b.Title)
 	})
 
+	actions := make([]msAdaptiveCardAction, 0, len(links))
+	for _, l := range links {
+		actions = append(actions, msAdaptiveCardAction{
+			Type:  "Action.OpenUrl",
+			Title: l.Name,
+			URL:   l.URL,
+		})
+	}
+
+	// Mention the users and tags in a text block below the facts.
+	entities := make([]msAdaptiveCardMention, 0, len(mentions))
+	mentionTexts := make([]string, 0, len(mentions))
+	for _, m := range mentions {
+		text := fmt.Sprintf("<at>%s</at>", m.Name)
+		mention := msAdaptiveCardMention{
+			Type: "mention",
+			Text: text,
+			Mentioned: msAdaptiveCardMentionedElement{
+				ID:   m.ID,
+				Name: m.Name,
+			},
+		}
+		if m.Type == apiv1beta3.MentionTag {
+			mention.Mentioned.Type = "tag"
+		}
+		entities = append(entities, mention)
+		mentionTexts = append(mentionTexts, text)
+	}
+
+	items := []msAdaptiveCardBodyElement{
+		{
+			Type: "TextBlock",
+			msAdaptiveCardTextBlock: &msAdaptiveCardTextBlock{
+				Text:   objName,
+				Size:   "large",
+				Weight: "bolder",
+				Wrap:   true,
+			},
+		},
+		{
+			Type:                    "TextBlock",
+			msAdaptiveCardTextBlock: message,
+		},
+		{
+			Type: "FactSet",
+			msAdaptiveCardFactSet: &msAdaptiveCardFactSet{
+				Facts: facts,
+			},
+		},
+	}
+	if len(mentionTexts) > 0 {
+		items = append(items, msAdaptiveCardBodyElement{
+			Type: "TextBlock",
+			msAdaptiveCardTextBlock: &msAdaptiveCardTextBlock{
+				Text: strings.Join(mentionTexts, " "),
+				Wrap: true,
+			},
+		})
+	}
+
 	// The card below was built with help from https://adaptivecards.io/designer using the Microsoft Teams host app.
 	payload := &msAdaptiveCardMessage{
 		Type: "message",
@@ -244,34 +380,17 @@ func buildMSTeamsAdaptiveCardPayload(event *eventv1.Event, objName string) *msAd
 					Schema:  "http://adaptivecards.io/schemas/adaptive-card.json",
 					Type:    "AdaptiveCard",
 					Version: msAdaptiveCardVersion,
+					Actions: actions,
 					MSTeams: msAdaptiveCardMSTeams{
-						Width: "Full",
+						Width:    "Full",
+						Entities: entities,
 					},
 					Body: []msAdaptiveCardBodyElement{
 						{
 							Type: "Container",
 							msAdaptiveCardContainer: &msAdaptiveCardContainer{
-								Items: []msAdaptiveCardBodyElement{
-									{
-										Type: "TextBlock",
-										msAdaptiveCardTextBlock: &msAdaptiveCardTextBlock{
-											Text:   objName,
-											Size:   "large",
-											Weight: "bolder",
-											Wrap:   true,
-										},
-									},
-									{
-										Type:                    "TextBlock",
-										msAdaptiveCardTextBlock: message,
-									},
-									{
-										Type: "FactSet",
-										msAdaptiveCardFactSet: &msAdaptiveCardFactSet{
-											Facts: facts,
-										},
-									},
-								},
+								Style: style,
+								Items: items,
 							},
 						},
 					},
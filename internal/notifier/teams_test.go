@@ -25,6 +25,10 @@ import (
 	"testing"
 
 	. "github.com/onsi/gomega"
+
+	eventv1 "github.com/fluxcd/pkg/apis/event/v1beta1"
+
+	apiv1beta3 "github.com/fluxcd/notification-controller/api/v1beta3"
 )
 
 func TestNewMSTeams(t *testing.T) {
@@ -58,9 +62,10 @@ func TestNewMSTeams(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			g := NewWithT(t)
-			teams, err := NewMSTeams(tt.url, "", nil)
+			teams, err := NewMSTeams(tt.url, "", nil, nil)
 			g.Expect(err).ToNot(HaveOccurred())
 			g.Expect(teams.Schema).To(Equal(tt.wantSchema))
+			g.Expect(teams.MetadataKeys).To(BeNil())
 		})
 	}
 }
@@ -106,7 +111,8 @@ func TestMSTeams_Post(t *testing.T) {
 						},
 						"body": []any{
 							map[string]any{
-								"type": "Container",
+								"type":  "Container",
+								"style": "good",
 								"items": []any{
 									map[string]any{
 										"type":   "TextBlock",
@@ -164,7 +170,7 @@ func TestMSTeams_Post(t *testing.T) {
 			g := NewWithT(t)
 			*tt.serverCalled = false
 
-			teams, err := NewMSTeams(tt.url, "", nil)
+			teams, err := NewMSTeams(tt.url, "", nil, nil)
 			g.Expect(err).ToNot(HaveOccurred())
 			teams.Schema = tt.schema
 
@@ -175,3 +181,86 @@ func TestMSTeams_Post(t *testing.T) {
 		})
 	}
 }
+
+func TestMSTeams_PostLinks(t *testing.T) {
+	links := []Link{{Name: "Dashboard", URL: "https://dashboard.example.com"}}
+
+	t.Run("deprecated connector", func(t *testing.T) {
+		g := NewWithT(t)
+		payload := buildMSTeamsDeprecatedConnectorPayload(&eventv1.Event{}, "objName", links)
+		g.Expect(payload.PotentialAction).To(Equal([]MSTeamsPotentialAction{
+			{Type: "OpenUri", Name: "Dashboard", Targets: []MSTeamsTarget{{OS: "default", URI: "https://dashboard.example.com"}}},
+		}))
+	})
+
+	t.Run("adaptive card", func(t *testing.T) {
+		g := NewWithT(t)
+		payload := buildMSTeamsAdaptiveCardPayload(&eventv1.Event{}, "objName", links, nil)
+		g.Expect(payload.Attachments[0].Content.Actions).To(Equal([]msAdaptiveCardAction{
+			{Type: "Action.OpenUrl", Title: "Dashboard", URL: "https://dashboard.example.com"},
+		}))
+	})
+}
+
+func TestMSTeams_PostMentionsAndFacts(t *testing.T) {
+	g := NewWithT(t)
+
+	var body []byte
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var err error
+		body, err = io.ReadAll(r.Body)
+		g.Expect(err).ToNot(HaveOccurred())
+	}))
+	defer ts.Close()
+
+	teams, err := NewMSTeams(ts.URL, "", nil, &apiv1beta3.MSTeamsOptions{MetadataKeys: []string{" revision", "summary ", ""}})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(teams.MetadataKeys).To(Equal([]string{"revision", "summary"}))
+
+	event := testEvent()
+	event.Severity = eventv1.EventSeverityError
+	event.Metadata = map[string]string{
+		"summary":  "production cluster",
+		"revision": "main@sha1:e1f2a3b4",
+		"token":    "secret",
+	}
+	mentions := []apiv1beta3.Mention{
+		{ID: "jane@example.com", Name: "Jane Doe"},
+		{ID: "MCMjMiMjZGVm", Name: "oncall", Type: apiv1beta3.MentionTag},
+	}
+	g.Expect(teams.Post(WithMentions(context.TODO(), mentions), event)).To(Succeed())
+
+	// The token key is not in the allowlist and must not be posted.
+	filtered := event
+	filtered.Metadata = map[string]string{
+		"summary":  "production cluster",
+		"revision": "main@sha1:e1f2a3b4",
+	}
+	expected := buildMSTeamsAdaptiveCardPayload(&filtered, "gitrepository/webapp.gitops-system", nil, mentions)
+	expectedBody, err := json.Marshal(expected)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(body).To(MatchJSON(expectedBody))
+
+	content := expected.Attachments[0].Content
+	g.Expect(content.MSTeams.Entities).To(Equal([]msAdaptiveCardMention{
+		{
+			Type:      "mention",
+			Text:      "<at>Jane Doe</at>",
+			Mentioned: msAdaptiveCardMentionedElement{ID: "jane@example.com", Name: "Jane Doe"},
+		},
+		{
+			Type:      "mention",
+			Text:      "<at>oncall</at>",
+			Mentioned: msAdaptiveCardMentionedElement{ID: "MCMjMiMjZGVm", Name: "oncall", Type: "tag"},
+		},
+	}))
+
+	container := content.Body[0].msAdaptiveCardContainer
+	g.Expect(container.Style).To(Equal("attention"))
+	g.Expect(container.Items).To(HaveLen(4))
+	g.Expect(container.Items[2].msAdaptiveCardFactSet.Facts).To(Equal([]msAdaptiveCardFact{
+		{Title: "summary", Value: "production cluster"},
+		{Title: "revision", Value: "main@sha1:e1f2a3b4"},
+	}))
+	g.Expect(container.Items[3].msAdaptiveCardTextBlock.Text).To(Equal("<at>Jane Doe</at> <at>oncall</at>"))
+}
@@ -0,0 +1,56 @@
+/*
+Copyright 2026 The Flux authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notifier
+
+import (
+	eventv1 "github.com/fluxcd/pkg/apis/event/v1beta1"
+	"github.com/fluxcd/pkg/cache"
+)
+
+// threadsCapacity is the maximum number of threads remembered across
+// all the providers, the least recently used threads are evicted first.
+const threadsCapacity = 10000
+
+// threads holds the root message IDs of the threads opened for the
+// involved objects, notifiers are created per event so the IDs are
+// kept at package level.
+var threads = func() *cache.LRU[string] {
+	c, _ := cache.NewLRU[string](threadsCapacity)
+	return c
+}()
+
+// threadKey returns the key of the thread of the event's involved
+// object in the given provider channel.
+func threadKey(providerUID, channel string, event eventv1.Event) string {
+	return providerUID + "/" + channel + "/" + involvedObjectID(event)
+}
+
+// getThread returns the root message ID of the thread of the event's
+// involved object, or an empty string if no thread was opened yet.
+func getThread(providerUID, channel string, event eventv1.Event) string {
+	id, err := threads.Get(threadKey(providerUID, channel, event))
+	if err != nil {
+		return ""
+	}
+	return id
+}
+
+// setThread remembers the root message ID of the thread of the event's
+// involved object.
+func setThread(providerUID, channel string, event eventv1.Event, id string) {
+	_ = threads.Set(threadKey(providerUID, channel, event), id)
+}
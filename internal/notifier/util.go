@@ -20,6 +20,7 @@ import (
 	"crypto/sha1"
 	"encoding/base64"
 	"fmt"
+	"sort"
 	"strings"
 	"unicode"
 	"unicode/utf8"
@@ -55,6 +56,29 @@ func formatNameAndDescription(event eventv1.Event) (string, string) {
 	return name, desc
 }
 
+// formatPushNotification returns the title and the plain text message of
+// a push notification for the event, with the metadata sorted by key.
+func formatPushNotification(event eventv1.Event) (string, string) {
+	title := fmt.Sprintf("%s/%s.%s", strings.ToLower(event.InvolvedObject.Kind),
+		event.InvolvedObject.Name, event.InvolvedObject.Namespace)
+
+	keys := make([]string, 0, len(event.Metadata))
+	for k := range event.Metadata {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var sb strings.Builder
+	sb.WriteString(event.Message)
+	if len(keys) > 0 {
+		sb.WriteString("\n")
+	}
+	for _, k := range keys {
+		fmt.Fprintf(&sb, "\n%s: %s", k, event.Metadata[k])
+	}
+	return title, sb.String()
+}
+
 func splitCamelcase(src string) (entries []string) {
 	// don't split invalid utf8
 	if !utf8.ValidString(src) {
@@ -113,6 +137,26 @@ func sha1String(str string) string {
 	return fmt.Sprintf("%x", sha1.Sum(bs))
 }
 
+// involvedObjectID returns the UID of the involved object, or the hash of
+// its kind, namespace and name if the event doesn't carry the UID.
+func involvedObjectID(event eventv1.Event) string {
+	if uid := event.InvolvedObject.UID; uid != "" {
+		return string(uid)
+	}
+	return sha1String(fmt.Sprintf("%s/%s/%s", event.InvolvedObject.Kind,
+		event.InvolvedObject.Namespace, event.InvolvedObject.Name))
+}
+
+// truncateString returns the string truncated to at most maxLen characters,
+// with an ellipsis replacing the end of the truncated strings.
+func truncateString(s string, maxLen int) string {
+	runes := []rune(s)
+	if len(runes) <= maxLen {
+		return s
+	}
+	return string(runes[:maxLen-3]) + "..."
+}
+
 func basicAuth(username, password string) string {
 	auth := username + ":" + password
 	return base64.StdEncoding.EncodeToString([]byte(auth))
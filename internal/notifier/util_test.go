@@ -153,6 +153,48 @@ func TestUtil_Sha1String(t *testing.T) {
 	g.Expect(s).To(Equal("12ea142172e98435e16336acbbed8919610922c3"))
 }
 
+func TestUtil_InvolvedObjectID(t *testing.T) {
+	g := NewWithT(t)
+	event := eventv1.Event{
+		InvolvedObject: corev1.ObjectReference{
+			Kind:      "Kustomization",
+			Namespace: "flux-system",
+			Name:      "apps",
+		},
+	}
+	g.Expect(involvedObjectID(event)).To(Equal(sha1String("Kustomization/flux-system/apps")))
+
+	event.InvolvedObject.UID = "8a5c9b1e-2d4f-4e6a-9b7c-1d2e3f4a5b6c"
+	g.Expect(involvedObjectID(event)).To(Equal("8a5c9b1e-2d4f-4e6a-9b7c-1d2e3f4a5b6c"))
+}
+
+func TestUtil_FormatPushNotification(t *testing.T) {
+	g := NewWithT(t)
+	event := eventv1.Event{
+		InvolvedObject: corev1.ObjectReference{
+			Kind:      "HelmRelease",
+			Namespace: "apps",
+			Name:      "podinfo",
+		},
+		Message: "Helm upgrade succeeded",
+	}
+
+	title, message := formatPushNotification(event)
+	g.Expect(title).To(Equal("helmrelease/podinfo.apps"))
+	g.Expect(message).To(Equal("Helm upgrade succeeded"))
+
+	event.Metadata = map[string]string{"revision": "6.5.4", "cluster": "prod"}
+	_, message = formatPushNotification(event)
+	g.Expect(message).To(Equal("Helm upgrade succeeded\n\ncluster: prod\nrevision: 6.5.4"))
+}
+
+func TestUtil_TruncateString(t *testing.T) {
+	g := NewWithT(t)
+	g.Expect(truncateString("message", 7)).To(Equal("message"))
+	g.Expect(truncateString("message", 6)).To(Equal("mes..."))
+	g.Expect(truncateString("🚨 failure", 6)).To(Equal("🚨 f..."))
+}
+
 func TestUtil_BasicAuth(t *testing.T) {
 	g := NewWithT(t)
 	username := "user"
@@ -78,7 +78,7 @@ func NewWebex(hookURL, proxyURL string, tlsConfig *tls.Config, channel string, t
 	}, nil
 }
 
-func (s *Webex) CreateMarkdown(event *eventv1.Event) string {
+func (s *Webex) CreateMarkdown(event *eventv1.Event, links ...Link) string {
 	var b strings.Builder
 	emoji := "✅"
 	if event.Severity == eventv1.EventSeverityError {
@@ -92,6 +92,9 @@ func (s *Webex) CreateMarkdown(event *eventv1.Event) string {
 			fmt.Fprintf(&b, ">**%s**: %s\n", k, v)
 		}
 	}
+	for _, l := range links {
+		fmt.Fprintf(&b, "[%s](%s)\n", l.Name, l.URL)
+	}
 	return b.String()
 }
 
@@ -99,7 +102,7 @@ func (s *Webex) CreateMarkdown(event *eventv1.Event) string {
 func (s *Webex) Post(ctx context.Context, event eventv1.Event) error {
 	payload := WebexPayload{
 		RoomId:   s.RoomId,
-		Markdown: s.CreateMarkdown(&event),
+		Markdown: s.CreateMarkdown(&event, GetLinks(ctx)...),
 	}
 
 	opts := []postOption{
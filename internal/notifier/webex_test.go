@@ -45,3 +45,22 @@ func TestWebex_Post(t *testing.T) {
 	err = webex.Post(context.TODO(), testEvent())
 	g.Expect(err).ToNot(HaveOccurred())
 }
+
+func TestWebex_PostLinks(t *testing.T) {
+	g := NewWithT(t)
+
+	var payload WebexPayload
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		g.Expect(json.NewDecoder(r.Body).Decode(&payload)).To(Succeed())
+	}))
+	defer ts.Close()
+
+	webex, err := NewWebex(ts.URL, "", nil, "room", "token")
+	g.Expect(err).ToNot(HaveOccurred())
+
+	ctx := WithLinks(context.TODO(), []Link{{Name: "Dashboard", URL: "https://flux.example.com"}})
+	g.Expect(webex.Post(ctx, testEvent())).To(Succeed())
+
+	g.Expect(payload.RoomId).To(Equal("room"))
+	g.Expect(payload.Markdown).To(HaveSuffix("[Dashboard](https://flux.example.com)\n"))
+}
@@ -0,0 +1,104 @@
+/*
+Copyright 2026 The Flux authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notifier
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net/url"
+	"strings"
+
+	eventv1 "github.com/fluxcd/pkg/apis/event/v1beta1"
+
+	apiv1beta3 "github.com/fluxcd/notification-controller/api/v1beta3"
+)
+
+// WeCom holds the group robot webhook URL.
+type WeCom struct {
+	URL       string
+	ProxyURL  string
+	TLSConfig *tls.Config
+}
+
+// WeComPayload holds the markdown message of the group robot.
+// See https://developer.work.weixin.qq.com/document/path/91770.
+type WeComPayload struct {
+	MsgType  string        `json:"msgtype"`
+	Markdown WeComMarkdown `json:"markdown"`
+}
+
+// WeComMarkdown holds the markdown content of the message.
+type WeComMarkdown struct {
+	Content string `json:"content"`
+}
+
+// NewWeCom creates a new WeCom (WeChat Work) notifier.
+//
+// Parameters:
+//   - address: group robot webhook URL with the key
+//   - proxyURL: Proxy URL (optional)
+//   - tlsConfig: TLS configuration (optional)
+//
+// Returns an error if the address is invalid.
+func NewWeCom(address string, proxyURL string, tlsConfig *tls.Config) (*WeCom, error) {
+	if _, err := url.ParseRequestURI(address); err != nil {
+		return nil, fmt.Errorf("invalid WeCom webhook URL (address) %q: %w", address, err)
+	}
+
+	return &WeCom{
+		URL:       address,
+		ProxyURL:  proxyURL,
+		TLSConfig: tlsConfig,
+	}, nil
+}
+
+// Post sends the event as a markdown message, mentioning the users of the
+// Alert mentions by user ID.
+func (w *WeCom) Post(ctx context.Context, event eventv1.Event) error {
+	_, content := formatChatBotMarkdown(event, GetLinks(ctx))
+
+	var mentions []string
+	for _, m := range GetMentions(ctx) {
+		if m.Type == apiv1beta3.MentionTag {
+			continue
+		}
+		mentions = append(mentions, fmt.Sprintf("<@%s>", m.ID))
+	}
+	if len(mentions) > 0 {
+		content += "\n\n" + strings.Join(mentions, " ")
+	}
+
+	payload := WeComPayload{
+		MsgType:  "markdown",
+		Markdown: WeComMarkdown{Content: content},
+	}
+
+	opts := []postOption{withResponseValidator(validateChatBotResponse)}
+	if w.ProxyURL != "" {
+		opts = append(opts, withProxy(w.ProxyURL))
+	}
+	if w.TLSConfig != nil {
+		opts = append(opts, withTLSConfig(w.TLSConfig))
+	}
+
+	if err := postMessage(ctx, w.URL, payload, opts...); err != nil {
+		return fmt.Errorf("postMessage failed: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,69 @@
+/*
+Copyright 2026 The Flux authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notifier
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	apiv1beta3 "github.com/fluxcd/notification-controller/api/v1beta3"
+)
+
+func TestNewWeCom(t *testing.T) {
+	g := NewWithT(t)
+
+	_, err := NewWeCom("wecom", "", nil)
+	g.Expect(err).To(MatchError(ContainSubstring("invalid WeCom webhook URL (address)")))
+
+	w, err := NewWeCom("https://qyapi.weixin.qq.com/cgi-bin/webhook/send?key=key", "", nil)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(w.URL).To(Equal("https://qyapi.weixin.qq.com/cgi-bin/webhook/send?key=key"))
+}
+
+func TestWeCom_Post(t *testing.T) {
+	g := NewWithT(t)
+
+	var payload WeComPayload
+	response := `{"errcode":0,"errmsg":"ok"}`
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		g.Expect(r.URL.Query().Get("key")).To(Equal("key"))
+		g.Expect(json.NewDecoder(r.Body).Decode(&payload)).To(Succeed())
+		_, _ = w.Write([]byte(response))
+	}))
+	defer ts.Close()
+
+	w, err := NewWeCom(ts.URL+"/cgi-bin/webhook/send?key=key", "", nil)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	ctx := WithMentions(context.TODO(), []apiv1beta3.Mention{{ID: "lilei", Name: "Li Lei"}})
+	g.Expect(w.Post(ctx, testEvent())).To(Succeed())
+	g.Expect(payload).To(Equal(WeComPayload{
+		MsgType: "markdown",
+		Markdown: WeComMarkdown{
+			Content: "#### 💫 gitrepository/webapp.gitops-system\n\nmessage\n\n> test: metadata\n\n<@lilei>",
+		},
+	}))
+
+	response = `{"errcode":93000,"errmsg":"invalid webhook url"}`
+	err = w.Post(context.TODO(), testEvent())
+	g.Expect(err).To(MatchError(ContainSubstring("webhook responded with error code 93000: invalid webhook url")))
+}
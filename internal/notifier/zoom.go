@@ -60,6 +60,7 @@ type ZoomSubHead struct {
 type ZoomBodyItem struct {
 	Type  string      `json:"type"`
 	Text  string      `json:"text,omitempty"`
+	Link  string      `json:"link,omitempty"`
 	Items []ZoomField `json:"items,omitempty"`
 }
 
@@ -123,6 +124,14 @@ func (s *Zoom) Post(ctx context.Context, event eventv1.Event) error {
 		})
 	}
 
+	for _, l := range GetLinks(ctx) {
+		body = append(body, ZoomBodyItem{
+			Type: "message",
+			Text: l.Name,
+			Link: l.URL,
+		})
+	}
+
 	payload := ZoomPayload{
 		Content: ZoomContent{
 			Head: ZoomHead{
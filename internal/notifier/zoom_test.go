@@ -80,3 +80,26 @@ func TestNewZoom(t *testing.T) {
 	_, err = NewZoom("https://integrations.zoom.us/chat/webhooks/incomingwebhook/id", "", nil, "")
 	g.Expect(err).To(MatchError(ContainSubstring("empty Zoom verification token")))
 }
+
+func TestZoom_PostLinks(t *testing.T) {
+	g := NewWithT(t)
+
+	var payload ZoomPayload
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		g.Expect(json.NewDecoder(r.Body).Decode(&payload)).To(Succeed())
+	}))
+	defer ts.Close()
+
+	zoom, err := NewZoom(ts.URL, "", nil, "token")
+	g.Expect(err).ToNot(HaveOccurred())
+
+	ctx := WithLinks(context.TODO(), []Link{{Name: "Dashboard", URL: "https://flux.example.com"}})
+	g.Expect(zoom.Post(ctx, testEvent())).To(Succeed())
+
+	g.Expect(payload.Content.Body).To(HaveLen(3))
+	g.Expect(payload.Content.Body[2]).To(Equal(ZoomBodyItem{
+		Type: "message",
+		Text: "Dashboard",
+		Link: "https://flux.example.com",
+	}))
+}
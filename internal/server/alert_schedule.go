@@ -0,0 +1,390 @@
+/*
+Copyright 2026 The Flux authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+	// Embed the IANA time zone database, so schedules can be
+	// evaluated on images without a zoneinfo directory.
+	_ "time/tzdata"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	eventv1 "github.com/fluxcd/pkg/apis/event/v1beta1"
+
+	apiv1beta3 "github.com/fluxcd/notification-controller/api/v1beta3"
+)
+
+const (
+	// digestReason is the reason of the digest events.
+	digestReason = "Digest"
+
+	// digestReportingController is the reporting controller of the digest events.
+	digestReportingController = "notification-controller"
+
+	// maxDigestEvents is the maximum number of events listed
+	// in a digest, the remaining events are only counted.
+	maxDigestEvents = 100
+)
+
+// scheduleWindow is a parsed AlertScheduleWindow, with the start and
+// end expressed as offsets from the start of the day.
+type scheduleWindow struct {
+	days  []time.Weekday
+	start time.Duration
+	end   time.Duration
+}
+
+// parseSchedule returns the location and the parsed windows of the schedule.
+func parseSchedule(schedule *apiv1beta3.AlertSchedule) (*time.Location, []scheduleWindow, error) {
+	loc, err := time.LoadLocation(schedule.TimeZone)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid time zone '%s': %w", schedule.TimeZone, err)
+	}
+
+	windows := make([]scheduleWindow, 0, len(schedule.Windows))
+	for _, w := range schedule.Windows {
+		var window scheduleWindow
+		for _, day := range w.Days {
+			weekday, err := parseWeekday(day)
+			if err != nil {
+				return nil, nil, err
+			}
+			window.days = append(window.days, weekday)
+		}
+		if window.start, err = parseTimeOfDay(w.Start); err != nil {
+			return nil, nil, err
+		}
+		if window.end, err = parseTimeOfDay(w.End); err != nil {
+			return nil, nil, err
+		}
+		if window.end <= window.start {
+			window.end += 24 * time.Hour
+		}
+		windows = append(windows, window)
+	}
+	return loc, windows, nil
+}
+
+func parseWeekday(day string) (time.Weekday, error) {
+	for d := time.Sunday; d <= time.Saturday; d++ {
+		if strings.EqualFold(d.String(), day) {
+			return d, nil
+		}
+	}
+	return 0, fmt.Errorf("invalid weekday '%s'", day)
+}
+
+func parseTimeOfDay(s string) (time.Duration, error) {
+	var hours, minutes int
+	if _, err := fmt.Sscanf(s, "%d:%d", &hours, &minutes); err != nil ||
+		hours < 0 || minutes < 0 || minutes > 59 || hours*60+minutes > 24*60 {
+		return 0, fmt.Errorf("invalid time of day '%s', expected HH:MM", s)
+	}
+	return time.Duration(hours)*time.Hour + time.Duration(minutes)*time.Minute, nil
+}
+
+// startsOn returns if the window starts on the given weekday.
+func (w scheduleWindow) startsOn(day time.Weekday) bool {
+	return len(w.days) == 0 || slices.Contains(w.days, day)
+}
+
+// at returns the time at the given offset of the day of t.
+func at(t time.Time, offset time.Duration) time.Time {
+	y, m, d := t.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, t.Location()).Add(offset)
+}
+
+// scheduleIsActive returns if the given time falls within any of the
+// schedule windows.
+func scheduleIsActive(schedule *apiv1beta3.AlertSchedule, now time.Time) (bool, error) {
+	loc, windows, err := parseSchedule(schedule)
+	if err != nil {
+		return false, err
+	}
+
+	now = now.In(loc)
+	for _, w := range windows {
+		// Windows starting yesterday may extend into today.
+		for _, day := range []time.Time{now, now.AddDate(0, 0, -1)} {
+			if !w.startsOn(day.Weekday()) {
+				continue
+			}
+			start, end := at(day, w.start), at(day, w.end)
+			if !now.Before(start) && now.Before(end) {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
+
+// nextScheduleStart returns the start of the first schedule
+// window opening after the given time.
+func nextScheduleStart(schedule *apiv1beta3.AlertSchedule, now time.Time) (time.Time, error) {
+	loc, windows, err := parseSchedule(schedule)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	now = now.In(loc)
+	var next time.Time
+	for i := 0; i <= 7; i++ {
+		day := now.AddDate(0, 0, i)
+		for _, w := range windows {
+			if !w.startsOn(day.Weekday()) {
+				continue
+			}
+			start := at(day, w.start)
+			if start.After(now) && (next.IsZero() || start.Before(next)) {
+				next = start
+			}
+		}
+		if !next.IsZero() {
+			return next, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("no schedule window found")
+}
+
+// applyAlertSchedule evaluates the schedule of the given Alert and returns
+// the Alert the event should be dispatched with. The returned Alert is nil
+// when the event is received outside the schedule windows and the event
+// was suppressed or deferred to a digest.
+func (s *EventServer) applyAlertSchedule(ctx context.Context, event *eventv1.Event,
+	alert *apiv1beta3.Alert, now time.Time) *apiv1beta3.Alert {
+	schedule := alert.Spec.Schedule
+	if schedule == nil {
+		return alert
+	}
+
+	active, err := scheduleIsActive(schedule, now)
+	if err != nil {
+		// Dispatch the event, a misconfigured schedule should
+		// not result in missed notifications.
+		log.FromContext(ctx).Error(err, "failed to evaluate alert schedule")
+		s.Eventf(alert, corev1.EventTypeWarning, "InvalidConfig",
+			"failed to evaluate schedule: %s", err)
+		return alert
+	}
+	if active {
+		return alert
+	}
+
+	switch schedule.OutsideWindows {
+	case apiv1beta3.ScheduleReroute:
+		if schedule.RerouteProviderRef == nil {
+			return alert
+		}
+		rerouted := alert.DeepCopy()
+		rerouted.Spec.ProviderRef = *schedule.RerouteProviderRef
+		return rerouted
+	case apiv1beta3.ScheduleDigest:
+		next, err := nextScheduleStart(schedule, now)
+		if err != nil {
+			log.FromContext(ctx).Error(err, "failed to compute next schedule window")
+			return nil
+		}
+		if s.digests != nil {
+			logger := log.FromContext(ctx)
+			alertKey := client.ObjectKeyFromObject(alert)
+			s.digests.add(alert, event, next.Sub(now), func(events []eventv1.Event, dropped int) {
+				s.dispatchDigest(log.IntoContext(context.Background(), logger), alertKey, events, dropped)
+			})
+		}
+		return nil
+	default:
+		return nil
+	}
+}
+
+// scheduleDeferral returns the delay until the start of the next window of
+// the Alert schedule, when the schedule suppresses or digests the events at
+// the given time. It returns zero when the events can be dispatched, i.e. when
+// the Alert has no schedule, the schedule is active or reroutes the events,
+// or the schedule can't be evaluated.
+func (s *EventServer) scheduleDeferral(ctx context.Context, alert *apiv1beta3.Alert, now time.Time) time.Duration {
+	schedule := alert.Spec.Schedule
+	if schedule == nil || schedule.OutsideWindows == apiv1beta3.ScheduleReroute {
+		return 0
+	}
+
+	active, err := scheduleIsActive(schedule, now)
+	if err != nil || active {
+		return 0
+	}
+	next, err := nextScheduleStart(schedule, now)
+	if err != nil {
+		log.FromContext(ctx).Error(err, "failed to compute next schedule window")
+		return 0
+	}
+	return next.Sub(now)
+}
+
+// digestBuffer holds the events deferred to a digest per Alert. The state is
+// kept in memory, hence pending digests are lost when the controller restarts.
+type digestBuffer struct {
+	mu      sync.Mutex
+	digests map[types.NamespacedName]*pendingDigest
+}
+
+// pendingDigest holds the events of a digest that is yet to be sent.
+type pendingDigest struct {
+	events  []eventv1.Event
+	dropped int
+}
+
+func newDigestBuffer() *digestBuffer {
+	return &digestBuffer{
+		digests: make(map[types.NamespacedName]*pendingDigest),
+	}
+}
+
+// add appends the event to the pending digest of the given Alert. When there
+// is no pending digest, a new one is started and flush is called with its
+// events after the given delay.
+func (b *digestBuffer) add(alert *apiv1beta3.Alert, event *eventv1.Event, delay time.Duration,
+	flush func(events []eventv1.Event, dropped int)) {
+	b.addEvents(alert, []eventv1.Event{*event}, 0, delay, flush)
+}
+
+// addEvents appends the events to the pending digest of the given Alert,
+// and adds the given count of dropped events to it, see add.
+func (b *digestBuffer) addEvents(alert *apiv1beta3.Alert, events []eventv1.Event, dropped int,
+	delay time.Duration, flush func(events []eventv1.Event, dropped int)) {
+	key := client.ObjectKeyFromObject(alert)
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	digest, ok := b.digests[key]
+	if !ok {
+		digest = &pendingDigest{}
+		b.digests[key] = digest
+		time.AfterFunc(delay, func() {
+			b.mu.Lock()
+			delete(b.digests, key)
+			b.mu.Unlock()
+			flush(digest.events, digest.dropped)
+		})
+	}
+
+	for i := range events {
+		if len(digest.events) < maxDigestEvents {
+			digest.events = append(digest.events, *events[i].DeepCopy())
+		} else {
+			digest.dropped++
+		}
+	}
+	digest.dropped += dropped
+}
+
+// newDigestEvent returns an event summarizing the given events, with the
+// Alert as the involved object.
+func newDigestEvent(alert *apiv1beta3.Alert, events []eventv1.Event, dropped int) *eventv1.Event {
+	severity := eventv1.EventSeverityInfo
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "%d events received outside the schedule of Alert %s/%s:",
+		len(events)+dropped, alert.Namespace, alert.Name)
+	for _, e := range events {
+		if e.Severity == eventv1.EventSeverityError {
+			severity = eventv1.EventSeverityError
+		}
+		fmt.Fprintf(&sb, "\n- [%s] %s: %s", e.Severity, involvedObjectString(e.InvolvedObject), e.Message)
+	}
+	if dropped > 0 {
+		fmt.Fprintf(&sb, "\n- and %d more", dropped)
+	}
+
+	return &eventv1.Event{
+		InvolvedObject: corev1.ObjectReference{
+			APIVersion: apiv1beta3.GroupVersion.String(),
+			Kind:       apiv1beta3.AlertKind,
+			Name:       alert.Name,
+			Namespace:  alert.Namespace,
+			UID:        alert.UID,
+		},
+		Severity:            severity,
+		Timestamp:           metav1.Now(),
+		Message:             sb.String(),
+		Reason:              digestReason,
+		ReportingController: digestReportingController,
+	}
+}
+
+// dispatchDigest sends a digest of the given events with the Alert. The Alert
+// is read again from the API to make sure it still exists and is not suspended,
+// and its schedule is evaluated again in case it changed since the events were
+// deferred.
+func (s *EventServer) dispatchDigest(ctx context.Context, alertKey types.NamespacedName,
+	events []eventv1.Event, dropped int) {
+	ctx, cancel := context.WithTimeout(ctx, 15*time.Second)
+	defer cancel()
+	logger := log.FromContext(ctx)
+
+	var alert apiv1beta3.Alert
+	if err := s.kubeClient.Get(ctx, alertKey, &alert); err != nil {
+		logger.Error(err, "failed to read alert for digest")
+		return
+	}
+	if alert.Spec.Suspend {
+		return
+	}
+
+	now := time.Now()
+	target := &alert
+	if schedule := alert.Spec.Schedule; schedule != nil {
+		if active, err := scheduleIsActive(schedule, now); err == nil && !active {
+			switch schedule.OutsideWindows {
+			case apiv1beta3.ScheduleReroute:
+				if schedule.RerouteProviderRef != nil {
+					target = alert.DeepCopy()
+					target.Spec.ProviderRef = *schedule.RerouteProviderRef
+				}
+			case apiv1beta3.ScheduleDigest:
+				if delay := s.scheduleDeferral(ctx, &alert, now); delay > 0 && s.digests != nil {
+					logger.V(1).Info("deferring digest to the next schedule window", "delay", delay.String())
+					baseLogger := logger
+					s.digests.addEvents(&alert, events, dropped, delay, func(events []eventv1.Event, dropped int) {
+						s.dispatchDigest(log.IntoContext(context.Background(), baseLogger), alertKey, events, dropped)
+					})
+					return
+				}
+			default:
+				logger.Info("discarding digest outside the schedule windows", "events", len(events)+dropped)
+				return
+			}
+		}
+	}
+
+	digest := newDigestEvent(&alert, events, dropped)
+	logger.Info("dispatching digest", "events", len(events)+dropped)
+	if _, err := s.dispatchNotification(ctx, digest, target, nil); err != nil {
+		logger.Error(err, "failed to dispatch digest")
+		s.Eventf(&alert, corev1.EventTypeWarning, "NotificationDispatchFailed",
+			"failed to dispatch digest: %s", err)
+	}
+}
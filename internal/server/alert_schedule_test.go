@@ -0,0 +1,500 @@
+/*
+Copyright 2026 The Flux authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	eventv1 "github.com/fluxcd/pkg/apis/event/v1beta1"
+	"github.com/fluxcd/pkg/apis/meta"
+
+	apiv1beta3 "github.com/fluxcd/notification-controller/api/v1beta3"
+)
+
+func TestScheduleIsActive(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// 2026-10-19 is a Monday.
+	tests := []struct {
+		name     string
+		schedule apiv1beta3.AlertSchedule
+		now      time.Time
+		active   bool
+		wantErr  bool
+	}{
+		{
+			name: "inside business hours",
+			schedule: apiv1beta3.AlertSchedule{
+				Windows: []apiv1beta3.AlertScheduleWindow{
+					{Days: []string{"Monday", "Tuesday"}, Start: "09:00", End: "17:00"},
+				},
+			},
+			now:    time.Date(2026, 10, 19, 9, 0, 0, 0, time.UTC),
+			active: true,
+		},
+		{
+			name: "at the end of business hours",
+			schedule: apiv1beta3.AlertSchedule{
+				Windows: []apiv1beta3.AlertScheduleWindow{
+					{Days: []string{"Monday"}, Start: "09:00", End: "17:00"},
+				},
+			},
+			now:    time.Date(2026, 10, 19, 17, 0, 0, 0, time.UTC),
+			active: false,
+		},
+		{
+			name: "on a day without windows",
+			schedule: apiv1beta3.AlertSchedule{
+				Windows: []apiv1beta3.AlertScheduleWindow{
+					{Days: []string{"Tuesday"}, Start: "09:00", End: "17:00"},
+				},
+			},
+			now:    time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC),
+			active: false,
+		},
+		{
+			name: "every day when days are not set",
+			schedule: apiv1beta3.AlertSchedule{
+				Windows: []apiv1beta3.AlertScheduleWindow{
+					{Start: "00:00", End: "24:00"},
+				},
+			},
+			now:    time.Date(2026, 10, 18, 23, 59, 0, 0, time.UTC),
+			active: true,
+		},
+		{
+			name: "window crossing midnight",
+			schedule: apiv1beta3.AlertSchedule{
+				Windows: []apiv1beta3.AlertScheduleWindow{
+					{Days: []string{"Sunday"}, Start: "22:00", End: "06:00"},
+				},
+			},
+			now:    time.Date(2026, 10, 19, 5, 59, 0, 0, time.UTC),
+			active: true,
+		},
+		{
+			name: "evaluated in the time zone",
+			schedule: apiv1beta3.AlertSchedule{
+				TimeZone: "Europe/Berlin",
+				Windows: []apiv1beta3.AlertScheduleWindow{
+					{Start: "09:00", End: "17:00"},
+				},
+			},
+			now:    time.Date(2026, 10, 19, 16, 30, 0, 0, berlin).UTC(),
+			active: true,
+		},
+		{
+			name: "invalid time zone",
+			schedule: apiv1beta3.AlertSchedule{
+				TimeZone: "Mars/Olympus_Mons",
+				Windows: []apiv1beta3.AlertScheduleWindow{
+					{Start: "09:00", End: "17:00"},
+				},
+			},
+			now:     time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			active, err := scheduleIsActive(&tt.schedule, tt.now)
+			if tt.wantErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(active).To(Equal(tt.active))
+		})
+	}
+}
+
+func TestNextScheduleStart(t *testing.T) {
+	g := NewWithT(t)
+
+	schedule := &apiv1beta3.AlertSchedule{
+		Windows: []apiv1beta3.AlertScheduleWindow{
+			{Days: []string{"Monday", "Tuesday", "Wednesday", "Thursday", "Friday"}, Start: "09:00", End: "17:00"},
+		},
+	}
+
+	// Friday evening to Monday morning.
+	next, err := nextScheduleStart(schedule, time.Date(2026, 10, 23, 18, 0, 0, 0, time.UTC))
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(next).To(Equal(time.Date(2026, 10, 26, 9, 0, 0, 0, time.UTC)))
+
+	// Monday night to Tuesday morning.
+	next, err = nextScheduleStart(schedule, time.Date(2026, 10, 19, 23, 0, 0, 0, time.UTC))
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(next).To(Equal(time.Date(2026, 10, 20, 9, 0, 0, 0, time.UTC)))
+}
+
+func TestApplyAlertSchedule(t *testing.T) {
+	// Monday at night.
+	now := time.Date(2026, 10, 19, 23, 0, 0, 0, time.UTC)
+
+	testAlert := &apiv1beta3.Alert{}
+	testAlert.Name = "alert-foo"
+	testAlert.Namespace = "foo-ns"
+	testAlert.Spec = apiv1beta3.AlertSpec{
+		ProviderRef: meta.LocalObjectReference{Name: "provider-foo"},
+		Schedule: &apiv1beta3.AlertSchedule{
+			Windows: []apiv1beta3.AlertScheduleWindow{
+				{Start: "09:00", End: "17:00"},
+			},
+		},
+	}
+
+	testEvent := &eventv1.Event{
+		InvolvedObject: corev1.ObjectReference{
+			Kind:      "Kustomization",
+			Name:      "foo",
+			Namespace: "foo-ns",
+		},
+		Severity: eventv1.EventSeverityError,
+		Message:  "health check failed",
+	}
+
+	tests := []struct {
+		name         string
+		now          time.Time
+		modifyFn     func(alert *apiv1beta3.Alert)
+		wantProvider string
+		wantDigest   bool
+	}{
+		{
+			name:         "inside the windows",
+			now:          time.Date(2026, 10, 19, 10, 0, 0, 0, time.UTC),
+			wantProvider: "provider-foo",
+		},
+		{
+			name: "no schedule",
+			modifyFn: func(alert *apiv1beta3.Alert) {
+				alert.Spec.Schedule = nil
+			},
+			wantProvider: "provider-foo",
+		},
+		{
+			name: "suppressed outside the windows",
+			modifyFn: func(alert *apiv1beta3.Alert) {
+				alert.Spec.Schedule.OutsideWindows = apiv1beta3.ScheduleSuppress
+			},
+		},
+		{
+			name: "rerouted outside the windows",
+			modifyFn: func(alert *apiv1beta3.Alert) {
+				alert.Spec.Schedule.OutsideWindows = apiv1beta3.ScheduleReroute
+				alert.Spec.Schedule.RerouteProviderRef = &meta.LocalObjectReference{Name: "provider-bar"}
+			},
+			wantProvider: "provider-bar",
+		},
+		{
+			name: "deferred to digest outside the windows",
+			modifyFn: func(alert *apiv1beta3.Alert) {
+				alert.Spec.Schedule.OutsideWindows = apiv1beta3.ScheduleDigest
+			},
+			wantDigest: true,
+		},
+		{
+			name: "invalid schedule dispatches the event",
+			modifyFn: func(alert *apiv1beta3.Alert) {
+				alert.Spec.Schedule.TimeZone = "invalid"
+			},
+			wantProvider: "provider-foo",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			alert := testAlert.DeepCopy()
+			if tt.modifyFn != nil {
+				tt.modifyFn(alert)
+			}
+			if tt.now.IsZero() {
+				tt.now = now
+			}
+
+			eventServer := EventServer{
+				logger:        log.Log,
+				EventRecorder: record.NewFakeRecorder(32),
+				digests:       newDigestBuffer(),
+			}
+
+			result := eventServer.applyAlertSchedule(context.TODO(), testEvent, alert, tt.now)
+			if tt.wantProvider == "" {
+				g.Expect(result).To(BeNil())
+			} else {
+				g.Expect(result).ToNot(BeNil())
+				g.Expect(result.Spec.ProviderRef.Name).To(Equal(tt.wantProvider))
+			}
+			if tt.wantDigest {
+				g.Expect(eventServer.digests.digests).To(HaveLen(1))
+			} else {
+				g.Expect(eventServer.digests.digests).To(BeEmpty())
+			}
+		})
+	}
+}
+
+func TestDigestBuffer(t *testing.T) {
+	g := NewWithT(t)
+
+	alert := &apiv1beta3.Alert{}
+	alert.Name = "alert-foo"
+	alert.Namespace = "foo-ns"
+
+	event := &eventv1.Event{
+		InvolvedObject: corev1.ObjectReference{
+			Kind:      "Kustomization",
+			Name:      "foo",
+			Namespace: "foo-ns",
+		},
+		Severity: eventv1.EventSeverityInfo,
+		Message:  "reconciliation finished",
+	}
+
+	type flushed struct {
+		events  []eventv1.Event
+		dropped int
+	}
+	results := make(chan flushed, 1)
+	buffer := newDigestBuffer()
+	for range maxDigestEvents + 2 {
+		buffer.add(alert, event, 50*time.Millisecond, func(events []eventv1.Event, dropped int) {
+			results <- flushed{events, dropped}
+		})
+	}
+
+	var result flushed
+	g.Eventually(results, "1s").Should(Receive(&result))
+	g.Expect(result.events).To(HaveLen(maxDigestEvents))
+	g.Expect(result.dropped).To(Equal(2))
+	g.Expect(buffer.digests).To(BeEmpty())
+
+	failed := event.DeepCopy()
+	failed.Severity = eventv1.EventSeverityError
+	failed.Message = "health check failed"
+	digest := newDigestEvent(alert, append(result.events[:1], *failed), result.dropped)
+	g.Expect(digest.Severity).To(Equal(eventv1.EventSeverityError))
+	g.Expect(digest.InvolvedObject.Kind).To(Equal(apiv1beta3.AlertKind))
+	g.Expect(digest.Reason).To(Equal(digestReason))
+	g.Expect(strings.Split(digest.Message, "\n")).To(Equal([]string{
+		"4 events received outside the schedule of Alert foo-ns/alert-foo:",
+		"- [info] Kustomization/foo-ns/foo: reconciliation finished",
+		"- [error] Kustomization/foo-ns/foo: health check failed",
+		"- and 2 more",
+	}))
+}
+
+func TestScheduleDeferral(t *testing.T) {
+	// Monday at night.
+	now := time.Date(2026, 10, 19, 23, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		schedule  *apiv1beta3.AlertSchedule
+		wantDelay time.Duration
+	}{
+		{
+			name: "no schedule",
+		},
+		{
+			name: "inside the windows",
+			schedule: &apiv1beta3.AlertSchedule{
+				Windows: []apiv1beta3.AlertScheduleWindow{{Start: "22:00", End: "06:00"}},
+			},
+		},
+		{
+			name: "suppressed outside the windows",
+			schedule: &apiv1beta3.AlertSchedule{
+				Windows:        []apiv1beta3.AlertScheduleWindow{{Start: "09:00", End: "17:00"}},
+				OutsideWindows: apiv1beta3.ScheduleSuppress,
+			},
+			wantDelay: 10 * time.Hour,
+		},
+		{
+			name: "digested outside the windows",
+			schedule: &apiv1beta3.AlertSchedule{
+				Windows:        []apiv1beta3.AlertScheduleWindow{{Start: "09:00", End: "17:00"}},
+				OutsideWindows: apiv1beta3.ScheduleDigest,
+			},
+			wantDelay: 10 * time.Hour,
+		},
+		{
+			name: "rerouted outside the windows",
+			schedule: &apiv1beta3.AlertSchedule{
+				Windows:            []apiv1beta3.AlertScheduleWindow{{Start: "09:00", End: "17:00"}},
+				OutsideWindows:     apiv1beta3.ScheduleReroute,
+				RerouteProviderRef: &meta.LocalObjectReference{Name: "provider-bar"},
+			},
+		},
+		{
+			name: "invalid schedule",
+			schedule: &apiv1beta3.AlertSchedule{
+				TimeZone: "invalid",
+				Windows:  []apiv1beta3.AlertScheduleWindow{{Start: "09:00", End: "17:00"}},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			alert := &apiv1beta3.Alert{}
+			alert.Spec.Schedule = tt.schedule
+			eventServer := EventServer{logger: log.Log}
+			g.Expect(eventServer.scheduleDeferral(context.TODO(), alert, now)).To(Equal(tt.wantDelay))
+		})
+	}
+}
+
+// inactiveWindow returns a schedule window that doesn't contain the given time.
+func inactiveWindow(now time.Time) apiv1beta3.AlertScheduleWindow {
+	hour := now.UTC().Hour()
+	return apiv1beta3.AlertScheduleWindow{
+		Start: fmt.Sprintf("%02d:00", (hour+2)%24),
+		End:   fmt.Sprintf("%02d:00", (hour+3)%24),
+	}
+}
+
+func TestDispatchDigest(t *testing.T) {
+	testNamespace := "foo-ns"
+
+	payloads := make(chan eventv1.Event, 1)
+	rcvServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var payload eventv1.Event
+		if err := json.NewDecoder(r.Body).Decode(&payload); err == nil {
+			payloads <- payload
+		}
+		w.WriteHeader(200)
+	}))
+	defer rcvServer.Close()
+
+	rerouteProvider := &apiv1beta3.Provider{}
+	rerouteProvider.Name = "provider-bar"
+	rerouteProvider.Namespace = testNamespace
+	rerouteProvider.Spec = apiv1beta3.ProviderSpec{
+		Type:    "generic",
+		Address: rcvServer.URL,
+	}
+
+	testAlert := &apiv1beta3.Alert{}
+	testAlert.Name = "alert-foo"
+	testAlert.Namespace = testNamespace
+	testAlert.Spec = apiv1beta3.AlertSpec{
+		ProviderRef: meta.LocalObjectReference{Name: "provider-foo"},
+		Schedule: &apiv1beta3.AlertSchedule{
+			Windows: []apiv1beta3.AlertScheduleWindow{inactiveWindow(time.Now())},
+		},
+	}
+
+	events := []eventv1.Event{
+		{
+			InvolvedObject: corev1.ObjectReference{
+				Kind:      "Kustomization",
+				Name:      "foo",
+				Namespace: testNamespace,
+			},
+			Severity: eventv1.EventSeverityError,
+			Message:  "health check failed",
+		},
+	}
+
+	tests := []struct {
+		name           string
+		outsideWindows string
+		wantDeferred   bool
+		wantDispatched bool
+	}{
+		{
+			name:           "defers the digest when the schedule changed",
+			outsideWindows: apiv1beta3.ScheduleDigest,
+			wantDeferred:   true,
+		},
+		{
+			name:           "discards the digest when the schedule suppresses events",
+			outsideWindows: apiv1beta3.ScheduleSuppress,
+		},
+		{
+			name:           "reroutes the digest when the schedule reroutes events",
+			outsideWindows: apiv1beta3.ScheduleReroute,
+			wantDispatched: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			alert := testAlert.DeepCopy()
+			alert.Spec.Schedule.OutsideWindows = tt.outsideWindows
+			if tt.outsideWindows == apiv1beta3.ScheduleReroute {
+				alert.Spec.Schedule.RerouteProviderRef = &meta.LocalObjectReference{Name: rerouteProvider.Name}
+			}
+
+			scheme := runtime.NewScheme()
+			g.Expect(apiv1beta3.AddToScheme(scheme)).ToNot(HaveOccurred())
+			g.Expect(corev1.AddToScheme(scheme)).ToNot(HaveOccurred())
+			eventServer := EventServer{
+				kubeClient:    fakeclient.NewClientBuilder().WithScheme(scheme).WithObjects(rerouteProvider, alert).Build(),
+				logger:        log.Log,
+				EventRecorder: record.NewFakeRecorder(32),
+				digests:       newDigestBuffer(),
+			}
+
+			eventServer.dispatchDigest(context.TODO(), client.ObjectKeyFromObject(alert), events, 1)
+
+			if tt.wantDeferred {
+				g.Expect(eventServer.digests.digests).To(HaveLen(1))
+				digest := eventServer.digests.digests[client.ObjectKeyFromObject(alert)]
+				g.Expect(digest.events).To(HaveLen(1))
+				g.Expect(digest.dropped).To(Equal(1))
+			} else {
+				g.Expect(eventServer.digests.digests).To(BeEmpty())
+			}
+			if tt.wantDispatched {
+				var payload eventv1.Event
+				g.Eventually(payloads, "2s").Should(Receive(&payload))
+				g.Expect(payload.Reason).To(Equal(digestReason))
+			} else {
+				g.Consistently(payloads, "200ms").ShouldNot(Receive())
+			}
+		})
+	}
+}
@@ -0,0 +1,245 @@
+/*
+Copyright 2026 The Flux authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	eventv1 "github.com/fluxcd/pkg/apis/event/v1beta1"
+
+	apiv1beta3 "github.com/fluxcd/notification-controller/api/v1beta3"
+)
+
+// escalationMetadataKey is the event metadata key holding the escalation
+// delay of an escalated notification.
+const escalationMetadataKey = "escalatedAfter"
+
+// escalationTracker keeps track of the open failures of involved objects
+// for Alerts with escalation steps. The state is kept in memory, hence
+// pending escalations are lost when the controller restarts.
+type escalationTracker struct {
+	mu       sync.Mutex
+	failures map[escalationKey]*openFailure
+}
+
+// escalationKey identifies an open failure of an involved object
+// for a given Alert.
+type escalationKey struct {
+	alert  types.NamespacedName
+	object string
+}
+
+// openFailure holds the escalation timers of an open failure, and the
+// number of steps that are yet to fire.
+type openFailure struct {
+	timers  []*time.Timer
+	pending int
+}
+
+func newEscalationTracker() *escalationTracker {
+	return &escalationTracker{
+		failures: make(map[escalationKey]*openFailure),
+	}
+}
+
+// open starts tracking the failure of the event involved object for the
+// given Alert, and schedules fire to be called for each escalation step.
+// When fire returns a positive delay, the step is deferred and fire is
+// called again after the delay. Opening a failure that is already tracked
+// is a no-op, so the steps are always timed from the first error event.
+func (t *escalationTracker) open(alert *apiv1beta3.Alert, event *eventv1.Event,
+	fire func(step apiv1beta3.AlertEscalation) time.Duration) {
+	key := escalationKey{
+		alert:  client.ObjectKeyFromObject(alert),
+		object: involvedObjectString(event.InvolvedObject),
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if _, ok := t.failures[key]; ok {
+		return
+	}
+
+	failure := &openFailure{
+		timers:  make([]*time.Timer, len(alert.Spec.Escalations)),
+		pending: len(alert.Spec.Escalations),
+	}
+	for i, step := range alert.Spec.Escalations {
+		var run func()
+		run = func() {
+			if delay := fire(step); delay > 0 {
+				t.rearm(key, failure, i, delay, run)
+				return
+			}
+			t.done(key, failure)
+		}
+		failure.timers[i] = time.AfterFunc(step.After.Duration, run)
+	}
+	t.failures[key] = failure
+}
+
+// rearm schedules the deferred step of the failure to run again after
+// the given delay, unless the failure was resolved in the meantime.
+func (t *escalationTracker) rearm(key escalationKey, failure *openFailure, step int,
+	delay time.Duration, run func()) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.failures[key] != failure {
+		return
+	}
+	failure.timers[step] = time.AfterFunc(delay, run)
+}
+
+// done drops the failure once all its escalation steps have fired,
+// unless it was already resolved or replaced.
+func (t *escalationTracker) done(key escalationKey, failure *openFailure) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	failure.pending--
+	if failure.pending <= 0 && t.failures[key] == failure {
+		delete(t.failures, key)
+	}
+}
+
+// resolve cancels the pending escalations of the event involved object
+// for all Alerts.
+func (t *escalationTracker) resolve(event *eventv1.Event) {
+	object := involvedObjectString(event.InvolvedObject)
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	for key, failure := range t.failures {
+		if key.object != object {
+			continue
+		}
+		failure.stop()
+		delete(t.failures, key)
+	}
+}
+
+// forget cancels the pending escalations of the given Alert for all
+// involved objects, e.g. when the Alert was deleted.
+func (t *escalationTracker) forget(alertKey types.NamespacedName) {
+	if t == nil {
+		return
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	for key, failure := range t.failures {
+		if key.alert != alertKey {
+			continue
+		}
+		failure.stop()
+		delete(t.failures, key)
+	}
+}
+
+// stop stops the escalation timers of the failure.
+func (f *openFailure) stop() {
+	for _, timer := range f.timers {
+		timer.Stop()
+	}
+}
+
+// trackEscalations opens a failure for Alerts with escalation steps when the
+// given event is an error, or cancels the pending escalations of the involved
+// object when the event signals a recovery.
+func (s *EventServer) trackEscalations(ctx context.Context, event *eventv1.Event, alert *apiv1beta3.Alert) {
+	if s.escalations == nil || len(alert.Spec.Escalations) == 0 ||
+		event.Severity != eventv1.EventSeverityError {
+		return
+	}
+
+	logger := log.FromContext(ctx)
+	alertKey := client.ObjectKeyFromObject(alert)
+	failedEvent := event.DeepCopy()
+	s.escalations.open(alert, failedEvent, func(step apiv1beta3.AlertEscalation) time.Duration {
+		return s.escalate(log.IntoContext(context.Background(), logger), alertKey, failedEvent, step)
+	})
+}
+
+// escalate dispatches the given failure event to the Provider of the
+// escalation step. The Alert is read again from the API to make sure the
+// escalation step is still configured and the Alert is not suspended.
+// When the Alert schedule suppresses or digests the events at the current
+// time, the step is not dispatched and the delay until the start of the
+// next schedule window is returned, so that the step can be deferred.
+func (s *EventServer) escalate(ctx context.Context, alertKey types.NamespacedName,
+	event *eventv1.Event, step apiv1beta3.AlertEscalation) time.Duration {
+	ctx, cancel := context.WithTimeout(ctx, 15*time.Second)
+	defer cancel()
+
+	logger := log.FromContext(ctx).WithValues("escalation", map[string]string{
+		"after":        step.After.Duration.String(),
+		"providerName": step.ProviderRef.Name,
+	})
+	ctx = log.IntoContext(ctx, logger)
+
+	var alert apiv1beta3.Alert
+	if err := s.kubeClient.Get(ctx, alertKey, &alert); err != nil {
+		if apierrors.IsNotFound(err) {
+			s.escalations.forget(alertKey)
+			return 0
+		}
+		logger.Error(err, "failed to read alert for escalation")
+		return 0
+	}
+	if len(alert.Spec.Escalations) == 0 {
+		s.escalations.forget(alertKey)
+		return 0
+	}
+	if alert.Spec.Suspend || !slices.Contains(alert.Spec.Escalations, step) {
+		return 0
+	}
+	if delay := s.scheduleDeferral(ctx, &alert, time.Now()); delay > 0 {
+		logger.V(1).Info("deferring escalation to the next schedule window", "delay", delay.String())
+		return delay
+	}
+
+	notification := event.DeepCopy()
+	if notification.Metadata == nil {
+		notification.Metadata = make(map[string]string)
+	}
+	notification.Metadata[eventv1.Group+"/"+escalationMetadataKey] = step.After.Duration.String()
+
+	escalated := alert.DeepCopy()
+	escalated.Spec.ProviderRef = step.ProviderRef
+	logger.Info("escalating notification", "message", notification.Message)
+	if _, err := s.dispatchNotification(ctx, notification, escalated, nil); err != nil {
+		logger.Error(err, "failed to dispatch escalation")
+		s.Eventf(&alert, corev1.EventTypeWarning, "NotificationDispatchFailed",
+			"failed to dispatch escalation for %s: %s", involvedObjectString(event.InvolvedObject),
+			fmt.Errorf("provider '%s': %w", step.ProviderRef.Name, err))
+	}
+	return 0
+}
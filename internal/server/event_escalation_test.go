@@ -0,0 +1,489 @@
+/*
+Copyright 2026 The Flux authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"maps"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	eventv1 "github.com/fluxcd/pkg/apis/event/v1beta1"
+	"github.com/fluxcd/pkg/apis/meta"
+
+	apiv1 "github.com/fluxcd/notification-controller/api/v1"
+	apiv1beta3 "github.com/fluxcd/notification-controller/api/v1beta3"
+)
+
+func TestEscalationTracker(t *testing.T) {
+	alert := &apiv1beta3.Alert{}
+	alert.Name = "alert-foo"
+	alert.Namespace = "foo-ns"
+	alert.Spec.Escalations = []apiv1beta3.AlertEscalation{
+		{
+			After:       metav1.Duration{Duration: 10 * time.Millisecond},
+			ProviderRef: meta.LocalObjectReference{Name: "provider-b"},
+		},
+		{
+			After:       metav1.Duration{Duration: 50 * time.Millisecond},
+			ProviderRef: meta.LocalObjectReference{Name: "provider-c"},
+		},
+	}
+
+	event := &eventv1.Event{
+		InvolvedObject: corev1.ObjectReference{
+			Kind:      "Kustomization",
+			Name:      "foo",
+			Namespace: "foo-ns",
+		},
+		Severity: eventv1.EventSeverityError,
+	}
+
+	t.Run("fires all steps", func(t *testing.T) {
+		g := NewWithT(t)
+
+		var mu sync.Mutex
+		var fired []string
+		tracker := newEscalationTracker()
+		tracker.open(alert, event, func(step apiv1beta3.AlertEscalation) time.Duration {
+			mu.Lock()
+			defer mu.Unlock()
+			fired = append(fired, step.ProviderRef.Name)
+			return 0
+		})
+
+		g.Eventually(func() []string {
+			mu.Lock()
+			defer mu.Unlock()
+			return append([]string{}, fired...)
+		}, "1s", "10ms").Should(Equal([]string{"provider-b", "provider-c"}))
+
+		// The failure is dropped once the last step fired.
+		g.Eventually(func() int {
+			tracker.mu.Lock()
+			defer tracker.mu.Unlock()
+			return len(tracker.failures)
+		}, "1s", "10ms").Should(BeZero())
+	})
+
+	t.Run("ignores repeated failures", func(t *testing.T) {
+		g := NewWithT(t)
+
+		var fired atomic.Int32
+		tracker := newEscalationTracker()
+		for range 3 {
+			tracker.open(alert, event, func(step apiv1beta3.AlertEscalation) time.Duration {
+				fired.Add(1)
+				return 0
+			})
+		}
+
+		g.Eventually(fired.Load, "1s", "10ms").Should(Equal(int32(2)))
+		g.Consistently(fired.Load, "100ms", "10ms").Should(Equal(int32(2)))
+	})
+
+	t.Run("resolve cancels pending steps", func(t *testing.T) {
+		g := NewWithT(t)
+
+		var fired atomic.Int32
+		tracker := newEscalationTracker()
+		tracker.open(alert, event, func(step apiv1beta3.AlertEscalation) time.Duration {
+			fired.Add(1)
+			return 0
+		})
+		g.Eventually(fired.Load, "1s", "5ms").Should(Equal(int32(1)))
+
+		recovery := event.DeepCopy()
+		recovery.Severity = eventv1.EventSeverityInfo
+		tracker.resolve(recovery)
+
+		g.Consistently(fired.Load, "100ms", "10ms").Should(Equal(int32(1)))
+		g.Expect(tracker.failures).To(BeEmpty())
+	})
+
+	t.Run("forget cancels pending steps of the alert", func(t *testing.T) {
+		g := NewWithT(t)
+
+		var fired atomic.Int32
+		tracker := newEscalationTracker()
+		tracker.open(alert, event, func(step apiv1beta3.AlertEscalation) time.Duration {
+			fired.Add(1)
+			return 0
+		})
+
+		otherAlert := alert.DeepCopy()
+		otherAlert.Name = "alert-bar"
+		tracker.open(otherAlert, event, func(step apiv1beta3.AlertEscalation) time.Duration {
+			fired.Add(1)
+			return 0
+		})
+
+		tracker.forget(client.ObjectKeyFromObject(alert))
+
+		g.Eventually(fired.Load, "1s", "10ms").Should(Equal(int32(2)))
+		g.Consistently(fired.Load, "100ms", "10ms").Should(Equal(int32(2)))
+	})
+
+	t.Run("defers steps", func(t *testing.T) {
+		g := NewWithT(t)
+
+		var mu sync.Mutex
+		calls := map[string]int{}
+		tracker := newEscalationTracker()
+		tracker.open(alert, event, func(step apiv1beta3.AlertEscalation) time.Duration {
+			mu.Lock()
+			defer mu.Unlock()
+			calls[step.ProviderRef.Name]++
+			// Defer the first step once.
+			if step.ProviderRef.Name == "provider-b" && calls["provider-b"] == 1 {
+				return 20 * time.Millisecond
+			}
+			return 0
+		})
+
+		g.Eventually(func() map[string]int {
+			mu.Lock()
+			defer mu.Unlock()
+			return maps.Clone(calls)
+		}, "1s", "10ms").Should(Equal(map[string]int{"provider-b": 2, "provider-c": 1}))
+		g.Eventually(func() int {
+			tracker.mu.Lock()
+			defer tracker.mu.Unlock()
+			return len(tracker.failures)
+		}, "1s", "10ms").Should(BeZero())
+	})
+
+	t.Run("resolve cancels deferred steps", func(t *testing.T) {
+		g := NewWithT(t)
+
+		var fired atomic.Int32
+		tracker := newEscalationTracker()
+		tracker.open(alert, event, func(step apiv1beta3.AlertEscalation) time.Duration {
+			fired.Add(1)
+			return 50 * time.Millisecond
+		})
+		g.Eventually(fired.Load, "1s", "5ms").Should(Equal(int32(1)))
+
+		recovery := event.DeepCopy()
+		recovery.Severity = eventv1.EventSeverityInfo
+		tracker.resolve(recovery)
+
+		g.Consistently(fired.Load, "150ms", "10ms").Should(Equal(int32(1)))
+	})
+
+	t.Run("resolve ignores other objects", func(t *testing.T) {
+		g := NewWithT(t)
+
+		var fired atomic.Int32
+		tracker := newEscalationTracker()
+		tracker.open(alert, event, func(step apiv1beta3.AlertEscalation) time.Duration {
+			fired.Add(1)
+			return 0
+		})
+
+		other := event.DeepCopy()
+		other.InvolvedObject.Name = "bar"
+		tracker.resolve(other)
+
+		g.Eventually(fired.Load, "1s", "10ms").Should(Equal(int32(2)))
+	})
+}
+
+func TestEscalate(t *testing.T) {
+	testNamespace := "foo-ns"
+
+	payloads := make(chan eventv1.Event, 1)
+	rcvServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var payload eventv1.Event
+		if err := json.NewDecoder(r.Body).Decode(&payload); err == nil {
+			payloads <- payload
+		}
+		w.WriteHeader(200)
+	}))
+	defer rcvServer.Close()
+
+	escalationProvider := &apiv1beta3.Provider{}
+	escalationProvider.Name = "provider-b"
+	escalationProvider.Namespace = testNamespace
+	escalationProvider.Spec = apiv1beta3.ProviderSpec{
+		Type:    "generic",
+		Address: rcvServer.URL,
+	}
+
+	step := apiv1beta3.AlertEscalation{
+		After:       metav1.Duration{Duration: 30 * time.Minute},
+		ProviderRef: meta.LocalObjectReference{Name: escalationProvider.Name},
+	}
+
+	testAlert := &apiv1beta3.Alert{}
+	testAlert.Name = "alert-foo"
+	testAlert.Namespace = testNamespace
+	testAlert.Spec = apiv1beta3.AlertSpec{
+		ProviderRef: meta.LocalObjectReference{Name: "provider-a"},
+		Escalations: []apiv1beta3.AlertEscalation{step},
+	}
+
+	testEvent := &eventv1.Event{
+		InvolvedObject: corev1.ObjectReference{
+			APIVersion: "kustomize.toolkit.fluxcd.io/v1",
+			Kind:       "Kustomization",
+			Name:       "foo",
+			Namespace:  testNamespace,
+		},
+		Severity: eventv1.EventSeverityError,
+		Message:  "health check failed",
+	}
+
+	tests := []struct {
+		name      string
+		modifyFn  func(alert *apiv1beta3.Alert)
+		escalated bool
+		deferred  bool
+	}{
+		{
+			name:      "dispatches to the escalation provider",
+			escalated: true,
+		},
+		{
+			name: "defers outside the schedule windows",
+			modifyFn: func(alert *apiv1beta3.Alert) {
+				alert.Spec.Schedule = &apiv1beta3.AlertSchedule{
+					Windows:        []apiv1beta3.AlertScheduleWindow{inactiveWindow(time.Now())},
+					OutsideWindows: apiv1beta3.ScheduleSuppress,
+				}
+			},
+			deferred: true,
+		},
+		{
+			name: "dispatches when the schedule reroutes events",
+			modifyFn: func(alert *apiv1beta3.Alert) {
+				alert.Spec.Schedule = &apiv1beta3.AlertSchedule{
+					Windows:            []apiv1beta3.AlertScheduleWindow{inactiveWindow(time.Now())},
+					OutsideWindows:     apiv1beta3.ScheduleReroute,
+					RerouteProviderRef: &meta.LocalObjectReference{Name: "provider-c"},
+				}
+			},
+			escalated: true,
+		},
+		{
+			name: "skips suspended alert",
+			modifyFn: func(alert *apiv1beta3.Alert) {
+				alert.Spec.Suspend = true
+			},
+		},
+		{
+			name: "skips removed escalation step",
+			modifyFn: func(alert *apiv1beta3.Alert) {
+				alert.Spec.Escalations[0].After = metav1.Duration{Duration: time.Hour}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			alert := testAlert.DeepCopy()
+			if tt.modifyFn != nil {
+				tt.modifyFn(alert)
+			}
+
+			scheme := runtime.NewScheme()
+			g.Expect(apiv1beta3.AddToScheme(scheme)).ToNot(HaveOccurred())
+			g.Expect(corev1.AddToScheme(scheme)).ToNot(HaveOccurred())
+			builder := fakeclient.NewClientBuilder().WithScheme(scheme)
+			builder.WithObjects(escalationProvider, alert)
+			eventServer := EventServer{
+				kubeClient:    builder.Build(),
+				logger:        log.Log,
+				EventRecorder: record.NewFakeRecorder(32),
+			}
+
+			delay := eventServer.escalate(context.TODO(), client.ObjectKeyFromObject(alert), testEvent, step)
+			if tt.deferred {
+				g.Expect(delay).To(BeNumerically(">", time.Hour))
+			} else {
+				g.Expect(delay).To(BeZero())
+			}
+
+			if tt.escalated {
+				var payload eventv1.Event
+				g.Eventually(payloads, "2s").Should(Receive(&payload))
+				g.Expect(payload.Message).To(Equal(testEvent.Message))
+				g.Expect(payload.Metadata).To(HaveKeyWithValue(escalationMetadataKey, "30m0s"))
+			} else {
+				g.Consistently(payloads, "200ms").ShouldNot(Receive())
+			}
+		})
+	}
+}
+
+func TestEscalate_ForgetsDeletedAlert(t *testing.T) {
+	g := NewWithT(t)
+
+	alert := &apiv1beta3.Alert{}
+	alert.Name = "alert-foo"
+	alert.Namespace = "foo-ns"
+	alert.Spec.Escalations = []apiv1beta3.AlertEscalation{
+		{
+			After:       metav1.Duration{Duration: time.Hour},
+			ProviderRef: meta.LocalObjectReference{Name: "provider-b"},
+		},
+		{
+			After:       metav1.Duration{Duration: 2 * time.Hour},
+			ProviderRef: meta.LocalObjectReference{Name: "provider-c"},
+		},
+	}
+	event := &eventv1.Event{
+		InvolvedObject: corev1.ObjectReference{
+			Kind:      "Kustomization",
+			Name:      "foo",
+			Namespace: "foo-ns",
+		},
+		Severity: eventv1.EventSeverityError,
+	}
+
+	scheme := runtime.NewScheme()
+	g.Expect(apiv1beta3.AddToScheme(scheme)).ToNot(HaveOccurred())
+	eventServer := EventServer{
+		kubeClient:    fakeclient.NewClientBuilder().WithScheme(scheme).Build(),
+		logger:        log.Log,
+		EventRecorder: record.NewFakeRecorder(32),
+		escalations:   newEscalationTracker(),
+	}
+	eventServer.escalations.open(alert, event, func(step apiv1beta3.AlertEscalation) time.Duration { return 0 })
+	g.Expect(eventServer.escalations.failures).To(HaveLen(1))
+
+	eventServer.escalate(context.TODO(), client.ObjectKeyFromObject(alert), event, alert.Spec.Escalations[0])
+	g.Expect(eventServer.escalations.failures).To(BeEmpty())
+}
+
+func TestHandleEvent_TracksEscalations(t *testing.T) {
+	testNamespace := "foo-ns"
+
+	// The Provider of the Alert doesn't exist, so the notification
+	// can't be dispatched, but the failure must still be tracked.
+	testAlert := &apiv1beta3.Alert{}
+	testAlert.Name = "alert-foo"
+	testAlert.Namespace = testNamespace
+	testAlert.Spec = apiv1beta3.AlertSpec{
+		ProviderRef:   meta.LocalObjectReference{Name: "missing"},
+		EventSeverity: eventv1.EventSeverityInfo,
+		EventSources: []apiv1.CrossNamespaceObjectReference{
+			{Kind: "Kustomization", Name: "*"},
+		},
+		Escalations: []apiv1beta3.AlertEscalation{
+			{
+				After:       metav1.Duration{Duration: time.Hour},
+				ProviderRef: meta.LocalObjectReference{Name: "on-call"},
+			},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		schedule *apiv1beta3.AlertSchedule
+	}{
+		{
+			name: "notification not dispatched",
+		},
+		{
+			name: "event suppressed outside the schedule windows",
+			schedule: &apiv1beta3.AlertSchedule{
+				Windows:        []apiv1beta3.AlertScheduleWindow{inactiveWindow(time.Now())},
+				OutsideWindows: apiv1beta3.ScheduleSuppress,
+			},
+		},
+		{
+			name: "event digested outside the schedule windows",
+			schedule: &apiv1beta3.AlertSchedule{
+				Windows:        []apiv1beta3.AlertScheduleWindow{inactiveWindow(time.Now())},
+				OutsideWindows: apiv1beta3.ScheduleDigest,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			alert := testAlert.DeepCopy()
+			alert.Spec.Schedule = tt.schedule
+
+			scheme := runtime.NewScheme()
+			g.Expect(apiv1beta3.AddToScheme(scheme)).ToNot(HaveOccurred())
+			g.Expect(corev1.AddToScheme(scheme)).ToNot(HaveOccurred())
+			eventServer := EventServer{
+				kubeClient:    fakeclient.NewClientBuilder().WithScheme(scheme).WithObjects(alert).Build(),
+				logger:        log.Log,
+				EventRecorder: record.NewFakeRecorder(32),
+				escalations:   newEscalationTracker(),
+				digests:       newDigestBuffer(),
+			}
+			handler := eventServer.handleEvent()
+
+			send := func(severity, reason string) {
+				event := &eventv1.Event{
+					InvolvedObject: corev1.ObjectReference{
+						APIVersion: "kustomize.toolkit.fluxcd.io/v1",
+						Kind:       "Kustomization",
+						Name:       "foo",
+						Namespace:  testNamespace,
+					},
+					Severity: severity,
+					Reason:   reason,
+					Message:  reason,
+				}
+				req := httptest.NewRequest("POST", "/", nil)
+				req = req.WithContext(context.WithValue(req.Context(), eventContextKey{}, event))
+				res := httptest.NewRecorder()
+				handler(res, req)
+				g.Expect(res.Code).To(Equal(http.StatusAccepted))
+			}
+			tracked := func() int {
+				eventServer.escalations.mu.Lock()
+				defer eventServer.escalations.mu.Unlock()
+				return len(eventServer.escalations.failures)
+			}
+
+			send(eventv1.EventSeverityError, meta.HealthCheckFailedReason)
+			g.Expect(tracked()).To(Equal(1))
+
+			// Informational events don't cancel the escalation.
+			send(eventv1.EventSeverityInfo, meta.DependencyNotReadyReason)
+			send(eventv1.EventSeverityInfo, meta.ProgressingReason)
+			g.Expect(tracked()).To(Equal(1))
+
+			send(eventv1.EventSeverityInfo, meta.ReconciliationSucceededReason)
+			g.Expect(tracked()).To(BeZero())
+		})
+	}
+}
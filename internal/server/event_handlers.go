@@ -66,7 +66,21 @@ func (s *EventServer) handleEvent() func(w http.ResponseWriter, r *http.Request)
 		// Remove any internal metadata before further processing the event.
 		excludeInternalMetadata(event)
 
-		alerts, err := s.getAllAlertsForEvent(ctx, event)
+		// Cancel the pending escalations if the involved object recovered.
+		if s.escalations != nil && notifier.IsRecoveryEvent(event) {
+			s.escalations.resolve(event)
+		}
+
+		// Track the failing state of the involved object for inhibition rules.
+		if s.failures != nil {
+			s.failures.record(event)
+		}
+
+		// The metadata of the involved object is read at most once, either
+		// when matching the alert sources or when enriching the notification.
+		objMeta := newObjectMetadata(event.InvolvedObject)
+
+		alerts, err := s.getAllAlertsForEvent(ctx, event, objMeta)
 		if err != nil {
 			eventLogger.Error(err, "failed to get alerts for the event")
 		}
@@ -80,6 +94,7 @@ func (s *EventServer) handleEvent() func(w http.ResponseWriter, r *http.Request)
 		eventLogger.Info("dispatching event", "message", event.Message)
 
 		// Dispatch notifications.
+		now := time.Now()
 		var droppedCommitStatusAlerts []*apiv1beta3.Alert
 		var droppedChangeRequestAlerts []*apiv1beta3.Alert
 		for i := range alerts {
@@ -91,7 +106,22 @@ func (s *EventServer) handleEvent() func(w http.ResponseWriter, r *http.Request)
 					"providerName": alert.Spec.ProviderRef.Name,
 				})
 			ctx := log.IntoContext(ctx, alertLogger)
-			dropped, err := s.dispatchNotification(ctx, event, alert)
+
+			// Track the failure whether or not the notification is dispatched,
+			// so that the escalation steps route around a broken provider, and
+			// fire once the schedule window opens for deferred events.
+			s.trackEscalations(ctx, event, alert)
+
+			// Apply the alert schedule, which may suppress or defer the
+			// event, or reroute it to an alternate provider.
+			scheduled := s.applyAlertSchedule(ctx, event, alert, now)
+			if scheduled == nil {
+				alertLogger.V(1).Info("event received outside the alert schedule windows",
+					"outsideWindows", alert.Spec.Schedule.OutsideWindows)
+				continue
+			}
+
+			dropped, err := s.dispatchNotification(ctx, event, scheduled, objMeta)
 			if err != nil {
 				alertLogger.Error(err, "failed to dispatch notification")
 				s.Eventf(alert, corev1.EventTypeWarning, "NotificationDispatchFailed",
@@ -134,20 +164,22 @@ func (s *EventServer) handleEvent() func(w http.ResponseWriter, r *http.Request)
 	}
 }
 
-func (s *EventServer) getAllAlertsForEvent(ctx context.Context, event *eventv1.Event) ([]apiv1beta3.Alert, error) {
+func (s *EventServer) getAllAlertsForEvent(ctx context.Context, event *eventv1.Event,
+	objMeta *objectMetadata) ([]apiv1beta3.Alert, error) {
 	var allAlerts apiv1beta3.AlertList
 	err := s.kubeClient.List(ctx, &allAlerts)
 	if err != nil {
 		return nil, fmt.Errorf("failed listing alerts: %w", err)
 	}
 
-	return s.filterAlertsForEvent(ctx, allAlerts.Items, event), nil
+	return s.filterAlertsForEvent(ctx, allAlerts.Items, event, objMeta), nil
 }
 
 // filterAlertsForEvent filters a given set of alerts against a given event,
-// checking if the event matches with any of the alert event sources and is
-// allowed by the exclusion list.
-func (s *EventServer) filterAlertsForEvent(ctx context.Context, alerts []apiv1beta3.Alert, event *eventv1.Event) []apiv1beta3.Alert {
+// checking if the event matches with any of the alert event sources, is
+// allowed by the exclusion list and is not inhibited by a failing source.
+func (s *EventServer) filterAlertsForEvent(ctx context.Context, alerts []apiv1beta3.Alert, event *eventv1.Event,
+	objMeta *objectMetadata) []apiv1beta3.Alert {
 	logger := log.FromContext(ctx)
 
 	results := make([]apiv1beta3.Alert, 0)
@@ -162,7 +194,7 @@ func (s *EventServer) filterAlertsForEvent(ctx context.Context, alerts []apiv1be
 		ctx := log.IntoContext(ctx, alertLogger)
 
 		// Check if the event matches any of the alert sources.
-		if !s.eventMatchesAlertSources(ctx, event, alert) {
+		if !s.eventMatchesAlertSources(ctx, event, alert, objMeta) {
 			continue
 		}
 		// Check if the event message is allowed for the alert based on the
@@ -175,6 +207,11 @@ func (s *EventServer) filterAlertsForEvent(ctx context.Context, alerts []apiv1be
 		if s.messageIsExcluded(ctx, event.Message, alert) {
 			continue
 		}
+		// Check if the event is suppressed by the alert inhibition rules
+		// due to a failing source object.
+		if s.eventIsInhibited(ctx, event, alert) {
+			continue
+		}
 		results = append(results, *alert)
 	}
 	return results
@@ -182,12 +219,13 @@ func (s *EventServer) filterAlertsForEvent(ctx context.Context, alerts []apiv1be
 
 // eventMatchesAlertSources returns if a given event matches with any of the
 // alert sources.
-func (s *EventServer) eventMatchesAlertSources(ctx context.Context, event *eventv1.Event, alert *apiv1beta3.Alert) bool {
+func (s *EventServer) eventMatchesAlertSources(ctx context.Context, event *eventv1.Event, alert *apiv1beta3.Alert,
+	objMeta *objectMetadata) bool {
 	for _, source := range alert.Spec.EventSources {
 		if source.Namespace == "" {
 			source.Namespace = alert.Namespace
 		}
-		if s.eventMatchesAlertSource(ctx, event, alert, source) {
+		if s.eventMatchesAlertSource(ctx, event, alert, source, objMeta) {
 			return true
 		}
 	}
@@ -239,11 +277,12 @@ func (s *EventServer) messageIsExcluded(ctx context.Context, msg string, alert *
 // dispatchNotification constructs and sends notification from the given event
 // and alert data. The returned struct indicates if the event was dropped due
 // to being related to a provider that requires a specific metadata key but the
-// event didn't have that key.
+// event didn't have that key. The metadata of the involved object already read
+// while handling the event can be given, otherwise it is read when required.
 func (s *EventServer) dispatchNotification(ctx context.Context,
-	event *eventv1.Event, alert *apiv1beta3.Alert) (droppedProviders, error) {
+	event *eventv1.Event, alert *apiv1beta3.Alert, objMeta *objectMetadata) (droppedProviders, error) {
 
-	params, dropped, err := s.getNotificationParams(ctx, event, alert)
+	params, dropped, err := s.getNotificationParams(ctx, event, alert, objMeta)
 	if err != nil {
 		return droppedProviders{}, err
 	}
@@ -255,6 +294,8 @@ func (s *EventServer) dispatchNotification(ctx context.Context,
 		pctx, cancel := context.WithTimeout(context.Background(), params.timeout)
 		defer cancel()
 		pctx = notifier.WithAlertMetadata(pctx, alert.ObjectMeta)
+		pctx = notifier.WithLinks(pctx, params.links)
+		pctx = notifier.WithMentions(pctx, alert.Spec.Mentions)
 		if err := n.Post(pctx, e); err != nil {
 			maskedErrStr, maskErr := masktoken.MaskTokenFromString(err.Error(), params.token)
 			if maskErr != nil {
@@ -275,6 +316,7 @@ func (s *EventServer) dispatchNotification(ctx context.Context,
 type notificationParams struct {
 	sender  notifier.Interface
 	event   *eventv1.Event
+	links   []notifier.Link
 	token   string
 	timeout time.Duration
 }
@@ -294,7 +336,7 @@ type droppedProviders struct {
 // due to being related to a provider that requires a specific metadata key but
 // the event didn't have that key is also returned.
 func (s *EventServer) getNotificationParams(ctx context.Context, event *eventv1.Event,
-	alert *apiv1beta3.Alert) (*notificationParams, droppedProviders, error) {
+	alert *apiv1beta3.Alert, objMeta *objectMetadata) (*notificationParams, droppedProviders, error) {
 	// Check if event comes from a different namespace.
 	if s.noCrossNamespaceRefs && event.InvolvedObject.Namespace != alert.Namespace {
 		accessDenied := fmt.Errorf(
@@ -353,7 +395,13 @@ func (s *EventServer) getNotificationParams(ctx context.Context, event *eventv1.
 
 	// Create a copy of the event and combine event metadata
 	notification := *event.DeepCopy()
-	s.combineEventMetadata(ctx, &notification, alert)
+	s.combineEventMetadata(ctx, &notification, alert, objMeta)
+
+	// Render the link-back URLs of the provider and the alert.
+	links, err := notifier.RenderLinks(append(slices.Clone(provider.Spec.Links), alert.Spec.Links...), &notification)
+	if err != nil {
+		log.FromContext(ctx).Error(err, "failed to render links, dispatching the notification without them")
+	}
 
 	// Create a commit status for the given provider and event, if applicable.
 	commitStatus, err := createCommitStatus(ctx, &provider, &notification, alert)
@@ -369,6 +417,7 @@ func (s *EventServer) getNotificationParams(ctx context.Context, event *eventv1.
 	return &notificationParams{
 		sender:  sender,
 		event:   &notification,
+		links:   links,
 		token:   token,
 		timeout: provider.GetTimeout(),
 	}, droppedProviders{}, nil
@@ -448,6 +497,7 @@ func createNotifier(ctx context.Context, kubeClient client.Client, provider *api
 		notifier.WithProviderUID(string(provider.UID)),
 		notifier.WithProviderName(provider.Name),
 		notifier.WithProviderNamespace(provider.Namespace),
+		notifier.WithProviderSpec(provider.Spec),
 	}
 
 	if commitStatus != "" {
@@ -575,7 +625,8 @@ func getTLSConfigForProvider(ctx context.Context, providerCertSecret, providerSe
 
 // eventMatchesAlertSource returns if a given event matches with the given alert
 // source configuration and severity.
-func (s *EventServer) eventMatchesAlertSource(ctx context.Context, event *eventv1.Event, alert *apiv1beta3.Alert, source apiv1.CrossNamespaceObjectReference) bool {
+func (s *EventServer) eventMatchesAlertSource(ctx context.Context, event *eventv1.Event, alert *apiv1beta3.Alert,
+	source apiv1.CrossNamespaceObjectReference, objMeta *objectMetadata) bool {
 	logger := log.FromContext(ctx)
 
 	// No match if the event and source don't have the same namespace and kind.
@@ -603,15 +654,8 @@ func (s *EventServer) eventMatchesAlertSource(ctx context.Context, event *eventv
 	}
 
 	// Perform label selector matching.
-	var obj metav1.PartialObjectMetadata
-	obj.SetGroupVersionKind(event.InvolvedObject.GroupVersionKind())
-	obj.SetName(event.InvolvedObject.Name)
-	obj.SetNamespace(event.InvolvedObject.Namespace)
-
-	if err := s.kubeClient.Get(ctx, types.NamespacedName{
-		Namespace: event.InvolvedObject.Namespace,
-		Name:      event.InvolvedObject.Name,
-	}, &obj); err != nil {
+	obj, err := s.getEventObjectMetadata(ctx, event, objMeta)
+	if err != nil {
 		logger.Error(err, "error getting the involved object")
 		s.Eventf(alert, corev1.EventTypeWarning, "SourceFetchFailed",
 			"error getting source object %s", involvedObjectString(event.InvolvedObject))
@@ -631,10 +675,93 @@ func (s *EventServer) eventMatchesAlertSource(ctx context.Context, event *eventv
 	return sel.Matches(labels.Set(obj.GetLabels()))
 }
 
+// getObjectMetadata returns the metadata of the referenced object.
+func (s *EventServer) getObjectMetadata(ctx context.Context, ref corev1.ObjectReference) (*metav1.PartialObjectMetadata, error) {
+	var obj metav1.PartialObjectMetadata
+	obj.SetGroupVersionKind(ref.GroupVersionKind())
+	obj.SetName(ref.Name)
+	obj.SetNamespace(ref.Namespace)
+
+	if err := s.kubeClient.Get(ctx, types.NamespacedName{
+		Namespace: ref.Namespace,
+		Name:      ref.Name,
+	}, &obj); err != nil {
+		return nil, err
+	}
+	return &obj, nil
+}
+
+// objectMetadata holds the metadata of an event involved object, read from
+// the API server at most once while the event is handled. The object read for
+// matching the Alert sources is reused when enriching the notifications.
+type objectMetadata struct {
+	ref     corev1.ObjectReference
+	fetched bool
+	obj     *metav1.PartialObjectMetadata
+	err     error
+}
+
+// newObjectMetadata returns an objectMetadata for the referenced object,
+// which is read on first use.
+func newObjectMetadata(ref corev1.ObjectReference) *objectMetadata {
+	return &objectMetadata{ref: ref}
+}
+
+// getEventObjectMetadata returns the metadata of the event involved object,
+// reading it only if objMeta hasn't read it yet. A nil objMeta reads the
+// object every time.
+func (s *EventServer) getEventObjectMetadata(ctx context.Context, event *eventv1.Event,
+	objMeta *objectMetadata) (*metav1.PartialObjectMetadata, error) {
+	if objMeta == nil {
+		return s.getObjectMetadata(ctx, event.InvolvedObject)
+	}
+	if !objMeta.fetched {
+		objMeta.obj, objMeta.err = s.getObjectMetadata(ctx, objMeta.ref)
+		objMeta.fetched = true
+	}
+	return objMeta.obj, objMeta.err
+}
+
+// getInvolvedObjectMetadata returns the labels and annotations of the involved
+// object allowed by the Alert .spec.involvedObjectMetadata. Failing to fetch
+// the object is not fatal, the event is dispatched without this metadata.
+func (s *EventServer) getInvolvedObjectMetadata(ctx context.Context, event *eventv1.Event,
+	alert *apiv1beta3.Alert, objMeta *objectMetadata) map[string]string {
+	allowlist := alert.Spec.InvolvedObjectMetadata
+	if allowlist == nil || (len(allowlist.Labels) == 0 && len(allowlist.Annotations) == 0) {
+		return nil
+	}
+
+	obj, err := s.getEventObjectMetadata(ctx, event, objMeta)
+	if err != nil {
+		log.FromContext(ctx).Error(err, "error getting the involved object metadata")
+		s.Eventf(alert, corev1.EventTypeWarning, "MetadataAppendFailed",
+			"failed to get the metadata of %s: %s", involvedObjectString(event.InvolvedObject), err)
+		return nil
+	}
+
+	metadata := make(map[string]string)
+	objLabels := obj.GetLabels()
+	for _, key := range allowlist.Labels {
+		if v, ok := objLabels[key]; ok {
+			metadata[key] = v
+		}
+	}
+	objAnnotations := obj.GetAnnotations()
+	for _, key := range allowlist.Annotations {
+		if v, ok := objAnnotations[key]; ok {
+			metadata[key] = v
+		}
+	}
+	return metadata
+}
+
 // combineEventMetadata combines all the sources of metadata for the event
 // according to the precedence order defined in RFC 0008. From lowest to
 // highest precedence, the sources are:
 //
+// 0) Involved object labels and annotations allowed by the Alert .spec.involvedObjectMetadata.
+//
 // 1) Event metadata keys prefixed with the Event API Group stripped of the prefix.
 //
 // 2) Alert .spec.eventMetadata with the keys as they are.
@@ -646,8 +773,10 @@ func (s *EventServer) eventMatchesAlertSource(ctx context.Context, event *eventv
 // At the end of the process key conflicts are detected and a single
 // info-level log is emitted to warn users about all the conflicts,
 // but only if at least one conflict is found.
-func (s *EventServer) combineEventMetadata(ctx context.Context, event *eventv1.Event, alert *apiv1beta3.Alert) {
+func (s *EventServer) combineEventMetadata(ctx context.Context, event *eventv1.Event, alert *apiv1beta3.Alert,
+	objMeta *objectMetadata) {
 	const (
+		sourceObjectMetadata     = "involved object labels and annotations"
 		sourceEventGroup         = "involved object annotations"
 		sourceAlertEventMetadata = "Alert object .spec.eventMetadata"
 		sourceAlertSummary       = "Alert object .spec.summary"
@@ -660,6 +789,12 @@ func (s *EventServer) combineEventMetadata(ctx context.Context, event *eventv1.E
 	metadata := make(map[string]string)
 	metadataSources := make(map[string][]string)
 
+	// 0) Involved object labels and annotations allowed by the Alert .spec.involvedObjectMetadata.
+	for k, v := range s.getInvolvedObjectMetadata(ctx, event, alert, objMeta) {
+		metadata[k] = v
+		metadataSources[k] = append(metadataSources[k], sourceObjectMetadata)
+	}
+
 	// 1) Event metadata keys prefixed with the Event API Group stripped of the prefix.
 	const eventGroupPrefix = eventv1.Group + "/"
 	for k, v := range event.Metadata {
@@ -23,6 +23,7 @@ import (
 	"crypto/tls"
 	"crypto/x509"
 	"crypto/x509/pkix"
+	"encoding/json"
 	"encoding/pem"
 	"fmt"
 	"math/big"
@@ -37,9 +38,12 @@ import (
 	. "github.com/onsi/gomega"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 
 	eventv1 "github.com/fluxcd/pkg/apis/event/v1beta1"
@@ -294,7 +298,7 @@ func TestFilterAlertsForEvent(t *testing.T) {
 				EventRecorder: record.NewFakeRecorder(32),
 			}
 
-			result := eventServer.filterAlertsForEvent(context.TODO(), alerts, testEvent)
+			result := eventServer.filterAlertsForEvent(context.TODO(), alerts, testEvent, nil)
 			g.Expect(len(result)).To(Equal(tt.resultAlertCount))
 		})
 	}
@@ -378,7 +382,7 @@ func TestDispatchNotification(t *testing.T) {
 				EventRecorder: record.NewFakeRecorder(32),
 			}
 
-			_, err := eventServer.dispatchNotification(context.TODO(), testEvent, alert)
+			_, err := eventServer.dispatchNotification(context.TODO(), testEvent, alert, nil)
 			g.Expect(err != nil).To(Equal(tt.wantErr))
 		})
 	}
@@ -596,7 +600,7 @@ func TestGetNotificationParams(t *testing.T) {
 				EventRecorder:        record.NewFakeRecorder(32),
 			}
 
-			params, dropped, err := eventServer.getNotificationParams(context.TODO(), event, alert)
+			params, dropped, err := eventServer.getNotificationParams(context.TODO(), event, alert, nil)
 			g.Expect(err != nil).To(Equal(tt.wantErr), "unexpected error: %v", err)
 			g.Expect(dropped.commitStatus).To(Equal(tt.wantDroppedCommitStatus))
 			g.Expect(params != nil).To(Equal(tt.wantParams), "unexpected params: %v", params)
@@ -1392,7 +1396,7 @@ func TestEventMatchesAlert(t *testing.T) {
 				},
 			}
 
-			result := eventServer.eventMatchesAlertSource(context.TODO(), tt.event, alert, tt.source)
+			result := eventServer.eventMatchesAlertSource(context.TODO(), tt.event, alert, tt.source, nil)
 			g.Expect(result).To(Equal(tt.wantResult))
 		})
 	}
@@ -1526,7 +1530,7 @@ func TestCombineEventMetadata(t *testing.T) {
 			}
 
 			tt.event.InvolvedObject.APIVersion = "kustomize.toolkit.fluxcd.io/v1"
-			s.combineEventMetadata(context.Background(), &tt.event, &tt.alert)
+			s.combineEventMetadata(context.Background(), &tt.event, &tt.alert, nil)
 			g.Expect(tt.event.Metadata).To(BeEquivalentTo(tt.expectedMetadata))
 
 			var event string
@@ -1539,6 +1543,200 @@ func TestCombineEventMetadata(t *testing.T) {
 	}
 }
 
+func TestCombineEventMetadata_involvedObjectMetadata(t *testing.T) {
+	ks := &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "kustomize.toolkit.fluxcd.io/v1",
+		"kind":       "Kustomization",
+		"metadata": map[string]any{
+			"name":      "apps",
+			"namespace": "foo-ns",
+			"labels": map[string]any{
+				"team":  "platform",
+				"other": "label",
+			},
+			"annotations": map[string]any{
+				"runbook-url": "https://runbooks.example.com/apps",
+			},
+		},
+	}}
+
+	for name, tt := range map[string]struct {
+		event            eventv1.Event
+		allowlist        *apiv1beta3.InvolvedObjectMetadata
+		expectedMetadata map[string]string
+		warningEvent     string
+	}{
+		"allowed labels and annotations are added": {
+			event: eventv1.Event{
+				InvolvedObject: corev1.ObjectReference{Name: "apps"},
+			},
+			allowlist: &apiv1beta3.InvolvedObjectMetadata{
+				Labels:      []string{"team", "owner"},
+				Annotations: []string{"runbook-url"},
+			},
+			expectedMetadata: map[string]string{
+				"team":        "platform",
+				"runbook-url": "https://runbooks.example.com/apps",
+			},
+		},
+		"event metadata takes precedence": {
+			event: eventv1.Event{
+				InvolvedObject: corev1.ObjectReference{Name: "apps"},
+				Metadata: map[string]string{
+					"event.toolkit.fluxcd.io/team": "apps",
+				},
+			},
+			allowlist: &apiv1beta3.InvolvedObjectMetadata{
+				Labels: []string{"team"},
+			},
+			expectedMetadata: map[string]string{
+				"team": "apps",
+			},
+			warningEvent: "Warning MetadataAppendFailed metadata key conflicts detected (please refer to the Alert API docs and Flux RFC 0008 for more information) map[team:involved object labels and annotations, involved object annotations]",
+		},
+		"missing object is ignored": {
+			event: eventv1.Event{
+				InvolvedObject: corev1.ObjectReference{Name: "missing"},
+			},
+			allowlist: &apiv1beta3.InvolvedObjectMetadata{
+				Labels: []string{"team"},
+			},
+			warningEvent: "Warning MetadataAppendFailed failed to get the metadata of Kustomization/foo-ns/missing: kustomizations.kustomize.toolkit.fluxcd.io \"missing\" not found",
+		},
+		"no allowlist": {
+			event: eventv1.Event{
+				InvolvedObject: corev1.ObjectReference{Name: "apps"},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			eventRecorder := record.NewFakeRecorder(1)
+			s := &EventServer{
+				kubeClient:    fakeclient.NewClientBuilder().WithObjects(ks.DeepCopy()).Build(),
+				logger:        log.Log,
+				EventRecorder: eventRecorder,
+			}
+
+			alert := &apiv1beta3.Alert{}
+			alert.Spec.InvolvedObjectMetadata = tt.allowlist
+
+			tt.event.InvolvedObject.APIVersion = "kustomize.toolkit.fluxcd.io/v1"
+			tt.event.InvolvedObject.Kind = "Kustomization"
+			tt.event.InvolvedObject.Namespace = "foo-ns"
+			s.combineEventMetadata(context.Background(), &tt.event, alert, nil)
+			g.Expect(tt.event.Metadata).To(BeEquivalentTo(tt.expectedMetadata))
+
+			var event string
+			select {
+			case event = <-eventRecorder.Events:
+			default:
+			}
+			g.Expect(event).To(Equal(tt.warningEvent))
+		})
+	}
+}
+
+func TestHandleEvent_ReusesInvolvedObjectMetadata(t *testing.T) {
+	g := NewWithT(t)
+	testNamespace := "foo-ns"
+
+	received := make(chan eventv1.Event, 1)
+	rcvServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var event eventv1.Event
+		g.Expect(json.NewDecoder(r.Body).Decode(&event)).To(Succeed())
+		received <- event
+	}))
+	defer rcvServer.Close()
+
+	ks := &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "kustomize.toolkit.fluxcd.io/v1",
+		"kind":       "Kustomization",
+		"metadata": map[string]any{
+			"name":      "apps",
+			"namespace": testNamespace,
+			"labels": map[string]any{
+				"team": "platform",
+			},
+		},
+	}}
+
+	provider := &apiv1beta3.Provider{}
+	provider.Name = "provider-foo"
+	provider.Namespace = testNamespace
+	provider.Spec = apiv1beta3.ProviderSpec{
+		Type:    "generic",
+		Address: rcvServer.URL,
+	}
+
+	alert := &apiv1beta3.Alert{}
+	alert.Name = "alert-foo"
+	alert.Namespace = testNamespace
+	alert.Spec = apiv1beta3.AlertSpec{
+		ProviderRef:   meta.LocalObjectReference{Name: provider.Name},
+		EventSeverity: eventv1.EventSeverityInfo,
+		EventSources: []apiv1.CrossNamespaceObjectReference{
+			{
+				Kind:        "Kustomization",
+				Name:        "*",
+				MatchLabels: map[string]string{"team": "platform"},
+			},
+		},
+		InvolvedObjectMetadata: &apiv1beta3.InvolvedObjectMetadata{
+			Labels: []string{"team"},
+		},
+	}
+
+	// Count the reads of the involved object metadata.
+	var gets int
+	scheme := runtime.NewScheme()
+	g.Expect(apiv1beta3.AddToScheme(scheme)).ToNot(HaveOccurred())
+	g.Expect(corev1.AddToScheme(scheme)).ToNot(HaveOccurred())
+	kubeClient := fakeclient.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(ks, provider, alert).
+		WithInterceptorFuncs(interceptor.Funcs{
+			Get: func(ctx context.Context, c client.WithWatch, key client.ObjectKey, obj client.Object, opts ...client.GetOption) error {
+				if _, ok := obj.(*metav1.PartialObjectMetadata); ok {
+					gets++
+				}
+				return c.Get(ctx, key, obj, opts...)
+			},
+		}).
+		Build()
+	eventServer := EventServer{
+		kubeClient:    kubeClient,
+		logger:        log.Log,
+		EventRecorder: record.NewFakeRecorder(32),
+	}
+
+	event := &eventv1.Event{
+		InvolvedObject: corev1.ObjectReference{
+			APIVersion: "kustomize.toolkit.fluxcd.io/v1",
+			Kind:       "Kustomization",
+			Name:       "apps",
+			Namespace:  testNamespace,
+		},
+		Severity: eventv1.EventSeverityInfo,
+		Reason:   meta.ReconciliationSucceededReason,
+		Message:  "applied",
+	}
+	req := httptest.NewRequest("POST", "/", nil)
+	req = req.WithContext(context.WithValue(req.Context(), eventContextKey{}, event))
+	res := httptest.NewRecorder()
+	eventServer.handleEvent()(res, req)
+	g.Expect(res.Code).To(Equal(http.StatusAccepted))
+
+	g.Expect(gets).To(Equal(1))
+	select {
+	case notification := <-received:
+		g.Expect(notification.Metadata).To(HaveKeyWithValue("team", "platform"))
+	case <-time.After(5 * time.Second):
+		t.Fatal("notification not received")
+	}
+}
+
 func Test_excludeInternalMetadata(t *testing.T) {
 	tests := []struct {
 		name         string
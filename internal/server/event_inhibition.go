@@ -0,0 +1,306 @@
+/*
+Copyright 2026 The Flux authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	eventv1 "github.com/fluxcd/pkg/apis/event/v1beta1"
+
+	apiv1 "github.com/fluxcd/notification-controller/api/v1"
+	apiv1beta3 "github.com/fluxcd/notification-controller/api/v1beta3"
+	"github.com/fluxcd/notification-controller/internal/notifier"
+)
+
+// sourceRefFields are the paths of the fields in which Flux objects
+// reference their source objects.
+var sourceRefFields = [][]string{
+	{"spec", "sourceRef"},
+	{"spec", "chartRef"},
+	{"spec", "chart", "spec", "sourceRef"},
+}
+
+const (
+	// failureTTL is the duration for which an object is considered failing
+	// after its last error event, so that objects which are deleted while
+	// failing don't inhibit the events of their dependents forever.
+	failureTTL = time.Hour
+
+	// sourceRefsTTL is the duration for which the source references
+	// of an involved object are cached.
+	sourceRefsTTL = 5 * time.Minute
+)
+
+// failureTracker keeps track of the involved objects which are in a failing
+// state, i.e. objects for which the last error event was received within the
+// TTL and wasn't followed by a recovery event. The state is kept in memory,
+// hence it is lost when the controller restarts.
+type failureTracker struct {
+	mu      sync.RWMutex
+	ttl     time.Duration
+	objects map[string]failingObject
+}
+
+// failingObject holds the reference of a failing object
+// and the time of its last error event.
+type failingObject struct {
+	ref      corev1.ObjectReference
+	lastSeen time.Time
+}
+
+func newFailureTracker() *failureTracker {
+	return &failureTracker{
+		ttl:     failureTTL,
+		objects: make(map[string]failingObject),
+	}
+}
+
+// record marks the event involved object as failing for error events,
+// and as recovered for recovery events. The expired objects are dropped.
+func (t *failureTracker) record(event *eventv1.Event) {
+	key := involvedObjectString(event.InvolvedObject)
+	now := time.Now()
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	switch {
+	case event.Severity == eventv1.EventSeverityError:
+		t.objects[key] = failingObject{ref: event.InvolvedObject, lastSeen: now}
+	case notifier.IsRecoveryEvent(event):
+		delete(t.objects, key)
+	}
+
+	for k, obj := range t.objects {
+		if now.Sub(obj.lastSeen) > t.ttl {
+			delete(t.objects, k)
+		}
+	}
+}
+
+// failing returns the references of the objects in a failing state.
+func (t *failureTracker) failing() []corev1.ObjectReference {
+	now := time.Now()
+
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	objects := make([]corev1.ObjectReference, 0, len(t.objects))
+	for _, obj := range t.objects {
+		if now.Sub(obj.lastSeen) <= t.ttl {
+			objects = append(objects, obj.ref)
+		}
+	}
+	return objects
+}
+
+// sourceRefCache caches the source references of the involved objects,
+// to avoid reading the objects from the API for every inhibited event.
+type sourceRefCache struct {
+	mu      sync.Mutex
+	ttl     time.Duration
+	entries map[string]sourceRefEntry
+}
+
+// sourceRefEntry holds the cached source references of an object.
+type sourceRefEntry struct {
+	refs      []corev1.ObjectReference
+	expiresAt time.Time
+}
+
+func newSourceRefCache() *sourceRefCache {
+	return &sourceRefCache{
+		ttl:     sourceRefsTTL,
+		entries: make(map[string]sourceRefEntry),
+	}
+}
+
+// get returns the cached source references of the given object.
+func (c *sourceRefCache) get(ref corev1.ObjectReference) ([]corev1.ObjectReference, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[involvedObjectString(ref)]
+	if !ok || time.Now().After(entry.expiresAt) {
+		return nil, false
+	}
+	return entry.refs, true
+}
+
+// set caches the source references of the given object,
+// and drops the expired entries.
+func (c *sourceRefCache) set(ref corev1.ObjectReference, refs []corev1.ObjectReference) {
+	now := time.Now()
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for k, entry := range c.entries {
+		if now.After(entry.expiresAt) {
+			delete(c.entries, k)
+		}
+	}
+	c.entries[involvedObjectString(ref)] = sourceRefEntry{refs: refs, expiresAt: now.Add(c.ttl)}
+}
+
+// eventIsInhibited returns if the given event is suppressed by any of the
+// alert inhibition rules, i.e. if a source object matching the rule is in
+// a failing state.
+func (s *EventServer) eventIsInhibited(ctx context.Context, event *eventv1.Event, alert *apiv1beta3.Alert) bool {
+	if s.failures == nil || len(alert.Spec.InhibitionRules) == 0 {
+		return false
+	}
+
+	failing := s.failures.failing()
+	if len(failing) == 0 {
+		return false
+	}
+
+	logger := log.FromContext(ctx)
+	eventObject := involvedObjectString(event.InvolvedObject)
+
+	// The source references of the involved object are only
+	// looked up once, and only if a rule requires them.
+	var sourceRefs []corev1.ObjectReference
+	var sourceRefsFetched bool
+
+	for _, rule := range alert.Spec.InhibitionRules {
+		if len(rule.TargetKinds) > 0 && !slices.Contains(rule.TargetKinds, event.InvolvedObject.Kind) {
+			continue
+		}
+		if len(rule.TargetReasons) > 0 && !slices.Contains(rule.TargetReasons, event.Reason) {
+			continue
+		}
+
+		source := rule.Source
+		if source.Namespace == "" {
+			source.Namespace = alert.Namespace
+		}
+
+		for _, obj := range failing {
+			// An object doesn't inhibit its own events.
+			if involvedObjectString(obj) == eventObject {
+				continue
+			}
+			if !s.objectMatchesSource(ctx, obj, source) {
+				continue
+			}
+			if rule.MatchSourceRef {
+				if !sourceRefsFetched {
+					var err error
+					sourceRefs, err = s.getSourceRefs(ctx, event.InvolvedObject)
+					if err != nil {
+						logger.Error(err, "error getting the source references of the involved object")
+					}
+					sourceRefsFetched = true
+				}
+				if !slices.ContainsFunc(sourceRefs, func(ref corev1.ObjectReference) bool {
+					return ref.Kind == obj.Kind && ref.Name == obj.Name && ref.Namespace == obj.Namespace
+				}) {
+					continue
+				}
+			}
+			logger.V(1).Info("event inhibited by failing source", "source", involvedObjectString(obj))
+			return true
+		}
+	}
+	return false
+}
+
+// objectMatchesSource returns if the given object matches
+// the inhibition rule source.
+func (s *EventServer) objectMatchesSource(ctx context.Context, obj corev1.ObjectReference,
+	source apiv1.CrossNamespaceObjectReference) bool {
+	if obj.Kind != source.Kind || obj.Namespace != source.Namespace {
+		return false
+	}
+	if source.Name != "*" && source.Name != obj.Name {
+		return false
+	}
+	if source.MatchLabels == nil {
+		return true
+	}
+
+	metadata, err := s.getObjectMetadata(ctx, obj)
+	if err != nil {
+		log.FromContext(ctx).Error(err, "error getting the inhibition source object",
+			"source", involvedObjectString(obj))
+		return false
+	}
+	sel, err := metav1.LabelSelectorAsSelector(&metav1.LabelSelector{
+		MatchLabels: source.MatchLabels,
+	})
+	if err != nil {
+		log.FromContext(ctx).Error(err, fmt.Sprintf("error using matchLabels from inhibition source %s",
+			crossNSObjectRefString(source)))
+		return false
+	}
+	return sel.Matches(labels.Set(metadata.GetLabels()))
+}
+
+// getSourceRefs returns the source objects referenced by the given object.
+// A reference without namespace defaults to the namespace of the object.
+// The references are cached, as the object is read from the API server.
+func (s *EventServer) getSourceRefs(ctx context.Context, ref corev1.ObjectReference) ([]corev1.ObjectReference, error) {
+	if s.sourceRefs != nil {
+		if refs, ok := s.sourceRefs.get(ref); ok {
+			return refs, nil
+		}
+	}
+
+	var obj unstructured.Unstructured
+	obj.SetGroupVersionKind(ref.GroupVersionKind())
+	if err := s.kubeClient.Get(ctx, types.NamespacedName{
+		Namespace: ref.Namespace,
+		Name:      ref.Name,
+	}, &obj); err != nil {
+		return nil, err
+	}
+
+	var refs []corev1.ObjectReference
+	for _, field := range sourceRefFields {
+		sourceRef, found, err := unstructured.NestedStringMap(obj.Object, field...)
+		if err != nil || !found {
+			continue
+		}
+		namespace := sourceRef["namespace"]
+		if namespace == "" {
+			namespace = ref.Namespace
+		}
+		refs = append(refs, corev1.ObjectReference{
+			Kind:      sourceRef["kind"],
+			Name:      sourceRef["name"],
+			Namespace: namespace,
+		})
+	}
+
+	if s.sourceRefs != nil {
+		s.sourceRefs.set(ref, refs)
+	}
+	return refs, nil
+}
@@ -0,0 +1,339 @@
+/*
+Copyright 2026 The Flux authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	eventv1 "github.com/fluxcd/pkg/apis/event/v1beta1"
+	"github.com/fluxcd/pkg/apis/meta"
+
+	apiv1 "github.com/fluxcd/notification-controller/api/v1"
+	apiv1beta3 "github.com/fluxcd/notification-controller/api/v1beta3"
+)
+
+func TestFailureTracker(t *testing.T) {
+	g := NewWithT(t)
+
+	repo := corev1.ObjectReference{Kind: "GitRepository", Name: "podinfo", Namespace: "foo-ns"}
+	tracker := newFailureTracker()
+
+	tracker.record(&eventv1.Event{InvolvedObject: repo, Severity: eventv1.EventSeverityError})
+	g.Expect(tracker.failing()).To(ConsistOf(repo))
+
+	tracker.record(&eventv1.Event{InvolvedObject: repo, Severity: eventv1.EventSeverityInfo, Reason: meta.ProgressingReason})
+	g.Expect(tracker.failing()).To(ConsistOf(repo))
+
+	tracker.record(&eventv1.Event{InvolvedObject: repo, Severity: eventv1.EventSeverityInfo, Reason: meta.DependencyNotReadyReason})
+	g.Expect(tracker.failing()).To(ConsistOf(repo))
+
+	tracker.record(&eventv1.Event{InvolvedObject: repo, Severity: eventv1.EventSeverityInfo, Reason: meta.SucceededReason})
+	g.Expect(tracker.failing()).To(BeEmpty())
+}
+
+func TestFailureTracker_Expiration(t *testing.T) {
+	g := NewWithT(t)
+
+	repo := corev1.ObjectReference{Kind: "GitRepository", Name: "podinfo", Namespace: "foo-ns"}
+	other := corev1.ObjectReference{Kind: "GitRepository", Name: "other", Namespace: "foo-ns"}
+	tracker := newFailureTracker()
+	tracker.ttl = 50 * time.Millisecond
+
+	tracker.record(&eventv1.Event{InvolvedObject: repo, Severity: eventv1.EventSeverityError})
+	g.Expect(tracker.failing()).To(ConsistOf(repo))
+
+	// The object is no longer failing once the TTL elapsed
+	// without error events, and is dropped on the next record.
+	g.Eventually(tracker.failing, "1s", "10ms").Should(BeEmpty())
+	tracker.record(&eventv1.Event{InvolvedObject: other, Severity: eventv1.EventSeverityError})
+	g.Expect(tracker.objects).To(HaveLen(1))
+	g.Expect(tracker.failing()).To(ConsistOf(other))
+}
+
+func TestGetSourceRefs(t *testing.T) {
+	g := NewWithT(t)
+
+	ks := &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "kustomize.toolkit.fluxcd.io/v1",
+		"kind":       "Kustomization",
+		"metadata": map[string]any{
+			"name":      "apps",
+			"namespace": "foo-ns",
+		},
+		"spec": map[string]any{
+			"sourceRef": map[string]any{
+				"kind":      "GitRepository",
+				"name":      "podinfo",
+				"namespace": "flux-system",
+			},
+		},
+	}}
+	ref := corev1.ObjectReference{
+		APIVersion: "kustomize.toolkit.fluxcd.io/v1",
+		Kind:       "Kustomization",
+		Name:       "apps",
+		Namespace:  "foo-ns",
+	}
+	want := []corev1.ObjectReference{
+		{Kind: "GitRepository", Name: "podinfo", Namespace: "flux-system"},
+	}
+
+	kubeClient := fakeclient.NewClientBuilder().WithScheme(runtime.NewScheme()).WithObjects(ks).Build()
+	eventServer := EventServer{
+		kubeClient: kubeClient,
+		logger:     log.Log,
+		sourceRefs: newSourceRefCache(),
+	}
+
+	refs, err := eventServer.getSourceRefs(context.TODO(), ref)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(refs).To(Equal(want))
+
+	// The references are served from the cache.
+	g.Expect(kubeClient.Delete(context.TODO(), ks)).To(Succeed())
+	refs, err = eventServer.getSourceRefs(context.TODO(), ref)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(refs).To(Equal(want))
+
+	// The object is read again once the entry expired.
+	eventServer.sourceRefs.ttl = -time.Second
+	eventServer.sourceRefs.set(ref, want)
+	_, err = eventServer.getSourceRefs(context.TODO(), ref)
+	g.Expect(err).To(HaveOccurred())
+}
+
+func TestEventIsInhibited(t *testing.T) {
+	testNamespace := "foo-ns"
+
+	repo, err := readManifest("./testdata/repo.yaml", testNamespace)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ks := &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "kustomize.toolkit.fluxcd.io/v1",
+		"kind":       "Kustomization",
+		"metadata": map[string]any{
+			"name":      "apps",
+			"namespace": testNamespace,
+		},
+		"spec": map[string]any{
+			"sourceRef": map[string]any{
+				"kind": "GitRepository",
+				"name": "podinfo",
+			},
+		},
+	}}
+
+	failingRepo := corev1.ObjectReference{
+		APIVersion: "source.toolkit.fluxcd.io/v1",
+		Kind:       "GitRepository",
+		Name:       "podinfo",
+		Namespace:  testNamespace,
+	}
+	otherRepo := corev1.ObjectReference{
+		APIVersion: "source.toolkit.fluxcd.io/v1",
+		Kind:       "GitRepository",
+		Name:       "other",
+		Namespace:  testNamespace,
+	}
+
+	ksEvent := &eventv1.Event{
+		InvolvedObject: corev1.ObjectReference{
+			APIVersion: "kustomize.toolkit.fluxcd.io/v1",
+			Kind:       "Kustomization",
+			Name:       "apps",
+			Namespace:  testNamespace,
+		},
+		Severity: eventv1.EventSeverityError,
+		Reason:   meta.DependencyNotReadyReason,
+		Message:  "dependency not ready",
+	}
+
+	tests := []struct {
+		name      string
+		failing   []corev1.ObjectReference
+		event     *eventv1.Event
+		rules     []apiv1beta3.InhibitionRule
+		inhibited bool
+	}{
+		{
+			name:    "no rules",
+			failing: []corev1.ObjectReference{failingRepo},
+			event:   ksEvent,
+		},
+		{
+			name:    "inhibited by failing source kind",
+			failing: []corev1.ObjectReference{failingRepo},
+			event:   ksEvent,
+			rules: []apiv1beta3.InhibitionRule{
+				{Source: apiv1.CrossNamespaceObjectReference{Kind: "GitRepository", Name: "*"}},
+			},
+			inhibited: true,
+		},
+		{
+			name:  "not inhibited without failing source",
+			event: ksEvent,
+			rules: []apiv1beta3.InhibitionRule{
+				{Source: apiv1.CrossNamespaceObjectReference{Kind: "GitRepository", Name: "*"}},
+			},
+		},
+		{
+			name:    "not inhibited by source in other namespace",
+			failing: []corev1.ObjectReference{failingRepo},
+			event:   ksEvent,
+			rules: []apiv1beta3.InhibitionRule{
+				{Source: apiv1.CrossNamespaceObjectReference{Kind: "GitRepository", Name: "*", Namespace: "bar-ns"}},
+			},
+		},
+		{
+			name:    "inhibited by failing source labels",
+			failing: []corev1.ObjectReference{failingRepo},
+			event:   ksEvent,
+			rules: []apiv1beta3.InhibitionRule{
+				{Source: apiv1.CrossNamespaceObjectReference{
+					Kind:        "GitRepository",
+					Name:        "*",
+					MatchLabels: map[string]string{"app": "podinfo"},
+				}},
+			},
+			inhibited: true,
+		},
+		{
+			name:    "not inhibited by source with other labels",
+			failing: []corev1.ObjectReference{failingRepo},
+			event:   ksEvent,
+			rules: []apiv1beta3.InhibitionRule{
+				{Source: apiv1.CrossNamespaceObjectReference{
+					Kind:        "GitRepository",
+					Name:        "*",
+					MatchLabels: map[string]string{"app": "other"},
+				}},
+			},
+		},
+		{
+			name:    "inhibited by target reason",
+			failing: []corev1.ObjectReference{failingRepo},
+			event:   ksEvent,
+			rules: []apiv1beta3.InhibitionRule{
+				{
+					Source:        apiv1.CrossNamespaceObjectReference{Kind: "GitRepository", Name: "podinfo"},
+					TargetKinds:   []string{"Kustomization"},
+					TargetReasons: []string{meta.DependencyNotReadyReason},
+				},
+			},
+			inhibited: true,
+		},
+		{
+			name:    "not inhibited for other target reasons",
+			failing: []corev1.ObjectReference{failingRepo},
+			event:   ksEvent,
+			rules: []apiv1beta3.InhibitionRule{
+				{
+					Source:        apiv1.CrossNamespaceObjectReference{Kind: "GitRepository", Name: "podinfo"},
+					TargetReasons: []string{meta.HealthCheckFailedReason},
+				},
+			},
+		},
+		{
+			name:    "not inhibited for other target kinds",
+			failing: []corev1.ObjectReference{failingRepo},
+			event:   ksEvent,
+			rules: []apiv1beta3.InhibitionRule{
+				{
+					Source:      apiv1.CrossNamespaceObjectReference{Kind: "GitRepository", Name: "podinfo"},
+					TargetKinds: []string{"HelmRelease"},
+				},
+			},
+		},
+		{
+			name:    "inhibited by referenced source",
+			failing: []corev1.ObjectReference{otherRepo, failingRepo},
+			event:   ksEvent,
+			rules: []apiv1beta3.InhibitionRule{
+				{
+					Source:         apiv1.CrossNamespaceObjectReference{Kind: "GitRepository", Name: "*"},
+					MatchSourceRef: true,
+				},
+			},
+			inhibited: true,
+		},
+		{
+			name:    "not inhibited by unreferenced source",
+			failing: []corev1.ObjectReference{otherRepo},
+			event:   ksEvent,
+			rules: []apiv1beta3.InhibitionRule{
+				{
+					Source:         apiv1.CrossNamespaceObjectReference{Kind: "GitRepository", Name: "*"},
+					MatchSourceRef: true,
+				},
+			},
+		},
+		{
+			name:    "source doesn't inhibit its own events",
+			failing: []corev1.ObjectReference{failingRepo},
+			event: &eventv1.Event{
+				InvolvedObject: failingRepo,
+				Severity:       eventv1.EventSeverityError,
+				Message:        "failed to checkout",
+			},
+			rules: []apiv1beta3.InhibitionRule{
+				{Source: apiv1.CrossNamespaceObjectReference{Kind: "GitRepository", Name: "*"}},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			alert := &apiv1beta3.Alert{}
+			alert.Name = "alert-foo"
+			alert.Namespace = testNamespace
+			alert.Spec.InhibitionRules = tt.rules
+
+			scheme := runtime.NewScheme()
+			g.Expect(apiv1beta3.AddToScheme(scheme)).ToNot(HaveOccurred())
+			builder := fakeclient.NewClientBuilder().WithScheme(scheme)
+			builder.WithObjects(repo.DeepCopy(), ks.DeepCopy())
+
+			eventServer := EventServer{
+				kubeClient:    builder.Build(),
+				logger:        log.Log,
+				EventRecorder: record.NewFakeRecorder(32),
+				failures:      newFailureTracker(),
+			}
+			for _, obj := range tt.failing {
+				eventServer.failures.record(&eventv1.Event{
+					InvolvedObject: obj,
+					Severity:       eventv1.EventSeverityError,
+				})
+			}
+
+			g.Expect(eventServer.eventIsInhibited(context.TODO(), tt.event, alert)).To(Equal(tt.inhibited))
+		})
+	}
+}
@@ -55,6 +55,10 @@ type EventServer struct {
 	noCrossNamespaceRefs  bool
 	exportHTTPPathMetrics bool
 	tokenCache            *cache.TokenCache
+	escalations           *escalationTracker
+	digests               *digestBuffer
+	failures              *failureTracker
+	sourceRefs            *sourceRefCache
 	kuberecorder.EventRecorder
 }
 
@@ -70,6 +74,10 @@ func NewEventServer(port string, logger logr.Logger, kubeClient client.Client,
 		noCrossNamespaceRefs:  noCrossNamespaceRefs,
 		exportHTTPPathMetrics: exportHTTPPathMetrics,
 		tokenCache:            tokenCache,
+		escalations:           newEscalationTracker(),
+		digests:               newDigestBuffer(),
+		failures:              newFailureTracker(),
+		sourceRefs:            newSourceRefCache(),
 	}
 }
 